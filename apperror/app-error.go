@@ -0,0 +1,18 @@
+package apperror
+
+import "errors"
+
+// 영상 생성 실패 원인 (errors.Is로 판별)
+// 각 계층은 원인 에러를 %w로 감싸서 반환하며, main(cli)은 원인별로 종료 코드를 구분합니다.
+var (
+	// ErrNoContent - 해당 날짜/타입에 사용할 콘텐츠가 없음
+	ErrNoContent = errors.New("콘텐츠 없음")
+	// ErrTTS - 음성 합성(gTTS, say) 실패
+	ErrTTS = errors.New("음성 합성(TTS) 실패")
+	// ErrFFmpeg - ffmpeg 실행 실패
+	ErrFFmpeg = errors.New("ffmpeg 실행 실패")
+	// ErrMissingTemplate - 템플릿 이미지 또는 폰트 파일 없음
+	ErrMissingTemplate = errors.New("템플릿/폰트 파일 없음")
+	// ErrInvalidContent - 렌더링 전 콘텐츠 검증 오류 (빈 발음, 슬라이드에 들어가지 않는 문장 등)
+	ErrInvalidContent = errors.New("콘텐츠 검증 실패")
+)
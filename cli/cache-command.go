@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/service"
+)
+
+// runCache - 음성/이미지/클립 캐시 관리 (cache prune, cache stats)
+func runCache(args []string) int {
+	if len(args) == 0 {
+		printCacheUsage()
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "prune":
+		return runCachePrune(args[1:])
+	case "stats":
+		return runCacheStats(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "알 수 없는 cache 명령입니다: %s\n\n", args[0])
+		printCacheUsage()
+		return exitUsage
+	}
+}
+
+func printCacheUsage() {
+	fmt.Fprintln(os.Stderr, "사용법: auto-video-service cache <prune|stats> [옵션]")
+	fmt.Fprintln(os.Stderr, "  prune   오래 사용하지 않은 캐시 파일을 삭제하여 용량을 줄입니다")
+	fmt.Fprintln(os.Stderr, "  stats   캐시 파일 수와 용량을 출력합니다")
+}
+
+// runCachePrune - 최대 용량/보관 기간을 넘는 캐시 파일을 오래 사용하지 않은 순서로 삭제합니다
+func runCachePrune(args []string) int {
+	fs := flag.NewFlagSet("cache prune", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	maxSize := fs.String("max-size", "", "남겨둘 최대 용량 (예: 500MB, 2GB. 기본값: config.json의 Cache.MaxSize 또는 2GB)")
+	maxAge := fs.Duration("max-age", 0, "마지막 사용 후 보관 기간 (예: 720h. 기본값: config.json의 Cache.MaxAge)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	config.InitConfig(common.appConfig())
+
+	limit := config.CacheMaxSize()
+	if *maxSize != "" {
+		size, err := config.ParseSize(*maxSize)
+		if err != nil {
+			log.Printf("에러: %v", err)
+			return exitUsage
+		}
+		limit = size
+	}
+	age := config.CacheMaxAge()
+	if *maxAge > 0 {
+		age = *maxAge
+	}
+
+	cache := service.DefaultAssetCache()
+	if !cache.Enabled() {
+		fmt.Println("캐시가 비활성화되어 있습니다 (Cache.Disabled).")
+		return exitOK
+	}
+
+	result, err := cache.Prune(limit, age, time.Now())
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	fmt.Printf("🧹 캐시 정리 완료: %s\n", cache.Dir())
+	fmt.Printf("  삭제: %d개\n", result.Removed)
+	fmt.Printf("  정리 전: %d개, %s\n", result.Before.Files, formatSize(result.Before.Bytes))
+	fmt.Printf("  정리 후: %d개, %s (최대 %s)\n", result.After.Files, formatSize(result.After.Bytes), formatSize(limit))
+	return exitOK
+}
+
+// runCacheStats - 캐시 사용량을 출력합니다
+func runCacheStats(args []string) int {
+	fs := flag.NewFlagSet("cache stats", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	config.InitConfig(common.appConfig())
+
+	cache := service.DefaultAssetCache()
+	if !cache.Enabled() {
+		fmt.Println("캐시가 비활성화되어 있습니다 (Cache.Disabled).")
+		return exitOK
+	}
+
+	stats, err := cache.Stats()
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	fmt.Printf("캐시 디렉토리: %s\n", cache.Dir())
+	fmt.Printf("  파일: %d개, 용량: %s (prune 기준 최대 %s)\n", stats.Files, formatSize(stats.Bytes), formatSize(config.CacheMaxSize()))
+	return exitOK
+}
+
+// formatSize - 바이트를 읽기 쉬운 단위로 변환합니다
+func formatSize(bytes int64) string {
+	switch {
+	case bytes >= 1<<30:
+		return fmt.Sprintf("%.2fGB", float64(bytes)/(1<<30))
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(bytes)/(1<<20))
+	case bytes >= 1<<10:
+		return fmt.Sprintf("%.1fKB", float64(bytes)/(1<<10))
+	default:
+		return fmt.Sprintf("%dB", bytes)
+	}
+}
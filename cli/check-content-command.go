@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+)
+
+// runCheckContent - 렌더링 없이 작업별 콘텐츠를 검증하여 행별 오류/경고를 출력합니다
+// 오류가 하나라도 있으면 exitInvalidContent로 종료하므로 스케줄 전에 CI/cron에서 사용할 수 있습니다.
+func runCheckContent(args []string) int {
+	fs := flag.NewFlagSet("check-content", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	asJSON := fs.Bool("json", false, "표 대신 JSON으로 출력")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	videoFactory := factory.NewVideoServiceFactory()
+	exitCode := exitOK
+	for _, job := range jobs {
+		report, err := videoFactory.ValidateVideo(ctx, job.Date, job.ServiceType)
+		if err != nil {
+			log.Printf("❌ 콘텐츠 검증 실패: 타입=%s, 날짜=%s: %v", job.ServiceType, job.Date, err)
+			exitCode = exitCodeFor(err)
+			continue
+		}
+
+		if *asJSON {
+			data, _ := json.MarshalIndent(report, "", "  ")
+			fmt.Println(string(data))
+		} else {
+			printValidationReport(report)
+		}
+		if report.Errors() > 0 && exitCode == exitOK {
+			exitCode = exitInvalidContent
+		}
+	}
+	return exitCode
+}
+
+// printValidationReport - 검증 결과를 행별 표로 출력합니다
+func printValidationReport(report dto.ValidationReport) {
+	fmt.Printf("\n🔎 %s %s (%d건, 템플릿 %s)\n", report.ServiceType, report.TargetDate, report.Rows, report.Template)
+	if len(report.Issues) == 0 {
+		fmt.Println("✅ 문제 없음")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ROW\tID\tFIELD\tLEVEL\tVALUE\tMESSAGE")
+	for _, issue := range report.Issues {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%q\t%s\n", issue.Row, issue.Id, issue.Field, issue.Severity, issue.Value, issue.Message)
+	}
+	w.Flush()
+	fmt.Printf("오류 %d건, 경고 %d건\n", report.Errors(), report.Warnings())
+}
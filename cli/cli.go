@@ -0,0 +1,269 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+	"auto-video-service/migration"
+)
+
+const (
+	defaultCliConfigPath = "config/config.yaml"
+	appConfigFileName    = "config.json"
+	profilesFileName     = "profiles.yaml"
+)
+
+// command - 하위 명령 정의
+type command struct {
+	summary string
+	run     func(args []string) int
+}
+
+var commands = map[string]command{
+	"generate":      {summary: "영상을 생성합니다 (기본 명령)", run: runGenerate},
+	"list-content":  {summary: "날짜별로 조회되는 콘텐츠를 출력합니다", run: runListContent},
+	"import":        {summary: "CSV/JSON/YAML 콘텐츠 팩을 검증하고 중복을 제외하여 DB에 저장합니다", run: runImport},
+	"duplicates":    {summary: "기간 안에서 다른 날짜에 반복된 단어/숙어/문장/롱폼 단어를 출력합니다", run: runDuplicates},
+	"migrate":       {summary: "DB 스키마 마이그레이션을 적용/되돌리거나 상태를 출력합니다 (up, down, status)", run: runMigrate},
+	"seed":          {summary: "샘플 콘텐츠 1주일을 DB에 저장합니다 (마이그레이션 포함)", run: runSeed},
+	"validate":      {summary: "설정 파일과 작업 목록을 검증합니다", run: runValidate},
+	"doctor":        {summary: "실행 파일, Python 모듈, 코덱, 폰트, 템플릿, DB 연결을 점검합니다", run: runDoctor},
+	"preview":       {summary: "음성/영상 없이 슬라이드 이미지만 생성합니다", run: runPreview},
+	"plan":          {summary: "렌더링 없이 콘텐츠, 슬라이드 텍스트, 클립 순서, 예상 길이를 출력합니다", run: runPlan},
+	"check-content": {summary: "렌더링 전에 콘텐츠를 템플릿 글자 수용 범위와 비교하여 행별 오류/경고를 출력합니다", run: runCheckContent},
+	"plan-shorts":   {summary: "미배정 longform_words에 유튜브 숏폼 날짜와 content_type을 배정합니다", run: runPlanShorts},
+	"cache":         {summary: "음성/이미지/클립 캐시를 관리합니다 (prune, stats)", run: runCache},
+	"serve":         {summary: "작업 등록/조회/다운로드 REST API 서버를 실행합니다", run: runServe},
+	"enqueue":       {summary: "작업을 DB 대기열에 등록합니다 (worker가 실행)", run: runEnqueue},
+	"worker":        {summary: "DB 대기열의 작업을 실행하는 worker를 시작합니다", run: runWorker},
+	"jobs":          {summary: "DB 대기열의 작업 목록과 상태를 출력합니다", run: runJobList},
+	"schedule":      {summary: "config.yaml의 schedules 일정에 따라 자동으로 생성합니다", run: runSchedule},
+}
+
+// Run - 명령줄 인자를 해석하여 하위 명령을 실행하고 종료 코드를 반환합니다
+// 하위 명령이 없으면 generate를 실행합니다 (기존 config.yaml 기반 동작).
+func Run(args []string) int {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return runGenerate(args)
+	}
+
+	name := args[0]
+	if name == "help" || name == "--help" {
+		printUsage()
+		return exitOK
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "알 수 없는 명령입니다: %s\n\n", name)
+		printUsage()
+		return exitUsage
+	}
+	return cmd.run(args[1:])
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "사용법: auto-video-service <명령> [옵션]")
+	fmt.Fprintln(os.Stderr, "\n명령:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(os.Stderr, "\n서비스 타입:")
+	if err := registerProfiles(filepath.Join(filepath.Dir(defaultCliConfigPath), profilesFileName)); err != nil {
+		fmt.Fprintf(os.Stderr, "  (프로필을 읽을 수 없습니다: %v)\n", err)
+	}
+	for _, spec := range factory.Specs() {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", spec.Code, spec.Description)
+	}
+	fmt.Fprintln(os.Stderr, "\n각 명령의 옵션은 '<명령> -h'로 확인할 수 있습니다.")
+}
+
+// signalContext - Ctrl-C(SIGINT) 또는 SIGTERM을 받으면 취소되는 컨텍스트를 만듭니다.
+// 취소되면 실행 중인 ffmpeg/say/python3 프로세스가 종료되고 임시 작업 디렉토리가 정리됩니다.
+func signalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// commonOptions - 모든 명령이 공유하는 설정 파일 경로 옵션
+type commonOptions struct {
+	configPath    string
+	appConfigPath string
+	profilesPath  string
+}
+
+func (o *commonOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.configPath, "config", defaultCliConfigPath, "CLI 설정 파일(YAML) 경로")
+	fs.StringVar(&o.appConfigPath, "app-config", "", "애플리케이션 설정 파일(JSON) 경로 (기본값: --config와 같은 디렉토리의 config.json)")
+	fs.StringVar(&o.profilesPath, "profiles", "", "플랫폼 프로필 파일(YAML) 경로 (기본값: --config와 같은 디렉토리의 profiles.yaml, 없으면 내장 기본값)")
+}
+
+// appConfig - 애플리케이션 설정(JSON) 경로. 지정하지 않으면 --config와 같은 디렉토리에서 찾습니다.
+func (o *commonOptions) appConfig() string {
+	if o.appConfigPath != "" {
+		return o.appConfigPath
+	}
+	return filepath.Join(filepath.Dir(o.configPath), appConfigFileName)
+}
+
+// profiles - 플랫폼 프로필 경로. 지정하지 않으면 --config와 같은 디렉토리에서 찾습니다.
+func (o *commonOptions) profiles() string {
+	if o.profilesPath != "" {
+		return o.profilesPath
+	}
+	return filepath.Join(filepath.Dir(o.configPath), profilesFileName)
+}
+
+// profilesRegistered - 프로필은 프로세스당 한 번만 레지스트리에 등록합니다
+var profilesRegistered bool
+
+// registerProfiles - 플랫폼 프로필을 읽어 숏폼 서비스 타입을 등록합니다
+func registerProfiles(path string) error {
+	if profilesRegistered {
+		return nil
+	}
+	profiles, err := config.LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	if err := factory.RegisterProfiles(profiles); err != nil {
+		return err
+	}
+	profilesRegistered = true
+	return nil
+}
+
+func (o *commonOptions) loadCliConfig() (*config.CliConfig, error) {
+	if err := registerProfiles(o.profiles()); err != nil {
+		return nil, err
+	}
+	return config.LoadCliConfig(o.configPath)
+}
+
+// initDatabase - 애플리케이션 설정 로드 및 DB 연결 (스키마는 확인하지 않음, migrate 명령용)
+func (o *commonOptions) initDatabase() {
+	config.InitConfig(o.appConfig())
+	config.ConfigureDatabase()
+}
+
+// initEnvironment - 애플리케이션 설정 로드, DB 연결, 스키마 확인
+// 적용되지 않은 마이그레이션이 있으면 'migrate up' 안내와 함께 에러를 반환합니다. 내장 SQLite만 처음 실행할 때(마이그레이션 기록이 없을 때)
+// 모든 마이그레이션을 자동으로 적용하고, MySQL은 운영 DB이므로 직접 'migrate up'을 실행해야 합니다. 호출하는 명령은 exitCodeFor로 종료 코드를 정합니다.
+func (o *commonOptions) initEnvironment() error {
+	o.initDatabase()
+	ctx := context.Background()
+	if !config.IsSQLite() {
+		if err := migration.Check(ctx); err != nil {
+			return fmt.Errorf("DB 준비 실패: %w", err)
+		}
+		return nil
+	}
+	applied, err := migration.Bootstrap(ctx)
+	if err != nil {
+		return fmt.Errorf("DB 준비 실패 (%s): %w", config.Config.Database.ConnectionString, err)
+	}
+	if len(applied) > 0 {
+		log.Printf("새 DB에 마이그레이션 %d건을 적용했습니다", len(applied))
+	}
+	return nil
+}
+
+// jobOptions - 작업 대상(타입/날짜)을 지정하는 옵션. 지정된 값은 YAML 값을 덮어씁니다.
+type jobOptions struct {
+	types string
+	date  string
+	from  string
+	to    string
+}
+
+func (o *jobOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.types, "type", "", "서비스 타입 (쉼표로 여러 개 지정 가능, 예: iw,ii). 목록은 'help' 참고")
+	fs.StringVar(&o.date, "date", "", "기준 날짜 (YYYYMMDD 또는 today)")
+	fs.StringVar(&o.from, "from", "", "날짜 범위 시작 (YYYYMMDD)")
+	fs.StringVar(&o.to, "to", "", "날짜 범위 끝 (YYYYMMDD)")
+}
+
+// apply - 플래그로 지정된 값을 YAML 설정에 덮어씁니다
+func (o *jobOptions) apply(cliCfg *config.CliConfig) {
+	if o.types != "" {
+		// 타입을 지정하면 YAML의 jobs 대신 플래그로 구성한 작업 하나만 실행
+		job := config.JobConfig{Types: splitList(o.types), Date: o.date, From: o.from, To: o.to}
+		if job.Date == "" && job.From == "" && job.To == "" {
+			job.Date = cliCfg.Video.Date
+		}
+		cliCfg.Jobs = []config.JobConfig{job}
+		return
+	}
+
+	if o.date == "" && o.from == "" && o.to == "" {
+		return
+	}
+
+	if len(cliCfg.Jobs) == 0 {
+		cliCfg.Jobs = []config.JobConfig{{Type: cliCfg.Video.Type}}
+	}
+	for i := range cliCfg.Jobs {
+		cliCfg.Jobs[i].Date = o.date
+		cliCfg.Jobs[i].From = o.from
+		cliCfg.Jobs[i].To = o.to
+	}
+}
+
+// resolveJobs - YAML 설정에 플래그를 적용하여 검증된 작업 목록을 만듭니다
+func resolveJobs(common *commonOptions, jobOpts *jobOptions) ([]dto.BatchJob, error) {
+	cliCfg, err := common.loadCliConfig()
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일을 읽는 중 에러 발생: %w", err)
+	}
+	jobOpts.apply(cliCfg)
+
+	jobs, err := cliCfg.ExpandJobs(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("jobs 설정이 올바르지 않습니다: %w", err)
+	}
+	if err := validateJobs(jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// validateJobs - 서비스 타입과 날짜 형식을 검사합니다
+func validateJobs(jobs []dto.BatchJob) error {
+	for _, job := range jobs {
+		if _, ok := factory.Lookup(job.ServiceType); !ok {
+			return fmt.Errorf("type 값이 올바르지 않습니다 (입력값: %s). 허용된 타입: %s", job.ServiceType, strings.Join(factory.Codes(), ", "))
+		}
+		if _, err := time.Parse("20060102", job.Date); err != nil {
+			return fmt.Errorf("날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", job.Date)
+		}
+	}
+	return nil
+}
+
+// splitList - 쉼표로 구분된 문자열을 목록으로 변환합니다
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"auto-video-service/config"
+	"auto-video-service/doctor"
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+)
+
+// runDoctor - 설정된 서비스 타입이 필요로 하는 실행 파일, Python 모듈, 코덱, 폰트, 템플릿, DB 연결을 검사합니다
+func runDoctor(args []string) int {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	all := fs.Bool("all", false, "config.yaml의 작업 대신 등록된 모든 서비스 타입을 검사")
+	quick := fs.Bool("quick", false, "실행 파일/폰트/템플릿 존재 여부만 빠르게 검사")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	var types []string
+	if *all {
+		if err := registerProfiles(common.profiles()); err != nil {
+			log.Printf("에러: %v", err)
+			return exitFailure
+		}
+		types = factory.Codes()
+	} else {
+		jobs, err := resolveJobs(&common, &jobOpts)
+		if err != nil {
+			log.Printf("에러: %v", err)
+			return exitFailure
+		}
+		types = jobTypes(jobs)
+	}
+
+	// DB 연결은 검사 항목이므로 initEnvironment 대신 설정만 로드
+	config.InitConfig(common.appConfig())
+
+	results := doctor.Run(context.Background(), doctor.Options{Types: types, Quick: *quick})
+	printDoctorResults(results)
+	if doctor.Failed(results) != nil {
+		return exitFailure
+	}
+	return exitOK
+}
+
+// preflight - 렌더링 전에 빠른 doctor 검사를 실행합니다. 실패 항목이 있으면 출력하고 에러를 반환합니다.
+func preflight(jobs []dto.BatchJob) error {
+	results := doctor.Run(context.Background(), doctor.Options{Types: jobTypes(jobs), Quick: true})
+	err := doctor.Failed(results)
+	if err != nil {
+		failed := make([]doctor.Result, 0)
+		for _, result := range results {
+			if result.Status == doctor.StatusFail {
+				failed = append(failed, result)
+			}
+		}
+		log.Println("❌ 사전 점검 실패 (전체 점검: doctor, 점검 생략: --skip-doctor)")
+		printDoctorResults(failed)
+	}
+	return err
+}
+
+// jobTypes - 작업 목록의 서비스 타입 (중복 제거, 순서 유지)
+func jobTypes(jobs []dto.BatchJob) []string {
+	seen := make(map[string]bool)
+	types := make([]string, 0)
+	for _, job := range jobs {
+		if !seen[job.ServiceType] {
+			seen[job.ServiceType] = true
+			types = append(types, job.ServiceType)
+		}
+	}
+	return types
+}
+
+func printDoctorResults(results []doctor.Result) {
+	icons := map[doctor.Status]string{doctor.StatusPass: "✅", doctor.StatusWarn: "⚠️", doctor.StatusFail: "❌"}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	passed := 0
+	for _, result := range results {
+		if result.Status == doctor.StatusPass {
+			passed++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", icons[result.Status], result.Name, result.Detail)
+		if result.Status != doctor.StatusPass && result.Hint != "" {
+			fmt.Fprintf(w, "\t  → %s\t\n", result.Hint)
+		}
+	}
+	w.Flush()
+	fmt.Printf("통과 %d / 전체 %d\n", passed, len(results))
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/service"
+)
+
+// runDuplicates - 기간 안에서 서로 다른 날짜에 반복된 단어/숙어/문장/롱폼 단어를 출력합니다
+func runDuplicates(args []string) int {
+	fs := flag.NewFlagSet("duplicates", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	from := fs.String("from", "", "검사 시작 날짜 (YYYYMMDD, 기본값: 오늘 - window일)")
+	to := fs.String("to", "", "검사 종료 날짜 (YYYYMMDD, 기본값: 오늘 + window일, 예약된 콘텐츠 포함)")
+	window := fs.Int("window", 0, "이 일수 이내에 반복되면 중복으로 판단 (기본값: config.json의 Content.DuplicateWindowDays 또는 30)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+	if *window <= 0 {
+		*window = config.DuplicateWindowDays()
+	}
+	if *window <= 0 {
+		log.Printf("에러: Content.DuplicateWindowDays가 음수(경고 끔)입니다. --window를 지정하세요")
+		return exitUsage
+	}
+
+	var err error
+	today, _ := time.ParseInLocation("20060102", time.Now().Format("20060102"), time.Local)
+	start, end := today.AddDate(0, 0, -*window), today.AddDate(0, 0, *window)
+	if *from != "" {
+		if start, err = parseDateFlag(*from); err != nil {
+			log.Printf("에러: %v", err)
+			return exitUsage
+		}
+	}
+	if *to != "" {
+		if end, err = parseDateFlag(*to); err != nil {
+			log.Printf("에러: %v", err)
+			return exitUsage
+		}
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	groups, err := service.NewContentDataService().FindDuplicates(ctx, start, end, *window)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	fmt.Printf("🔁 중복 콘텐츠 %s ~ %s (%d일 이내 반복)\n", start.Format("20060102"), end.Format("20060102"), *window)
+	if len(groups) == 0 {
+		fmt.Println("중복된 콘텐츠가 없습니다.")
+		return exitOK
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tDATES\tIDS\tTEXT")
+	for _, group := range groups {
+		ids := make([]string, 0, len(group.Entries))
+		texts := make([]string, 0, len(group.Entries))
+		for _, e := range group.Entries {
+			ids = append(ids, fmt.Sprint(e.Id))
+			if !slices.Contains(texts, e.Text) {
+				texts = append(texts, e.Text)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", group.Kind, strings.Join(group.Dates(), ","), strings.Join(ids, ","), strings.Join(texts, " / "))
+	}
+	w.Flush()
+	fmt.Printf("\n중복 %d건\n", len(groups))
+	return exitOK
+}
+
+// parseDateFlag - YYYYMMDD 또는 today
+func parseDateFlag(value string) (time.Time, error) {
+	parsed, err := time.ParseInLocation("20060102", config.ResolveDate(value, time.Now()), time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", value)
+	}
+	return parsed, nil
+}
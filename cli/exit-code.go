@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"auto-video-service/apperror"
+)
+
+// 종료 코드 (스케줄러/스크립트에서 실패 원인을 구분할 수 있도록 원인별로 분리)
+const (
+	exitOK              = 0
+	exitFailure         = 1   // 기타 실패 (설정 오류, DB 오류 등)
+	exitUsage           = 2   // 잘못된 명령/플래그
+	exitNoContent       = 3   // 해당 날짜의 콘텐츠 없음
+	exitTTS             = 4   // 음성 합성 실패
+	exitFFmpeg          = 5   // ffmpeg 실패
+	exitMissingTemplate = 6   // 템플릿/폰트 파일 없음
+	exitTimeout         = 7   // 작업 제한 시간 초과
+	exitInvalidContent  = 8   // 렌더링 전 콘텐츠 검증 오류
+	exitCanceled        = 130 // Ctrl-C 등으로 중단
+)
+
+// exitCodeFor - 에러 원인에 해당하는 종료 코드를 반환합니다
+func exitCodeFor(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, context.Canceled):
+		return exitCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitTimeout
+	case errors.Is(err, apperror.ErrNoContent):
+		return exitNoContent
+	case errors.Is(err, apperror.ErrTTS):
+		return exitTTS
+	case errors.Is(err, apperror.ErrFFmpeg):
+		return exitFFmpeg
+	case errors.Is(err, apperror.ErrMissingTemplate):
+		return exitMissingTemplate
+	case errors.Is(err, apperror.ErrInvalidContent):
+		return exitInvalidContent
+	default:
+		return exitFailure
+	}
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+	"auto-video-service/progress"
+)
+
+// runGenerate - 설정(YAML + 플래그)에 따라 영상을 생성합니다
+func runGenerate(args []string) int {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	var progressOpts progressOptions
+	var planOpts planOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	progressOpts.register(fs)
+	planOpts.register(fs)
+	dryRun := fs.Bool("dry-run", false, "렌더링하지 않고 plan만 출력 (plan 명령과 같음)")
+	skipDoctor := fs.Bool("skip-doctor", false, "렌더링 전 사전 점검(doctor --quick)을 생략")
+	force := fs.Bool("force", false, "이미 생성된 영상(생성 이력 또는 최종 파일)이 있어도 다시 생성")
+	allowInvalid := fs.Bool("allow-invalid", false, "렌더링 전 콘텐츠 검증 오류(빈 발음, 슬라이드에 들어가지 않는 문장 등)가 있어도 생성")
+	workers := fs.Int("workers", 0, "항목별 음성/영상 동시 생성 수 (기본값: config.json의 Render.Workers 또는 CPU 수, 최대 4)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if err := progressOpts.validate(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+	if *workers > 0 {
+		config.Config.Render.Workers = *workers
+	}
+	if *allowInvalid {
+		config.Config.Content.AllowInvalid = true
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	if *dryRun {
+		return runPlanJobs(ctx, factory.NewVideoServiceFactory(), jobs, &planOpts)
+	}
+
+	// 실행 파일/폰트/템플릿이 없으면 렌더링 도중이 아니라 시작 전에 실패
+	if !*skipDoctor {
+		if err := preflight(jobs); err != nil {
+			return exitCodeFor(err)
+		}
+	}
+
+	sink, err := progressOpts.sink()
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	startedAt := time.Now()
+	videoFactory := factory.NewVideoServiceFactory()
+	results := runJobs(ctx, videoFactory, jobs, sink, *force)
+	sink.Close()
+
+	if len(jobs) > 1 {
+		printSummary(results)
+	}
+	// 보고서는 중단(Ctrl-C)된 경우에도 저장 (ffprobe는 중단과 무관하게 실행)
+	if err := progressOpts.writeReport(context.Background(), startedAt, results); err != nil {
+		log.Printf("에러: %v", err)
+	}
+
+	// 실패한 작업이 있으면 첫 번째 실패 원인의 종료 코드를 반환
+	for _, result := range results {
+		if !result.Success {
+			return exitCodeFor(result.Error)
+		}
+	}
+	return exitOK
+}
+
+// runJobs - 배치 작업을 순서대로 실행합니다. 실패한 작업이 있어도 나머지 작업은 계속 진행합니다.
+// 작업별 진행 이벤트는 sink로 보내고, 단계별 소요 시간은 결과에 담습니다.
+// force가 아니면 이미 생성된 영상이 있는 작업은 건너뛰고 성공(Skipped)으로 기록합니다.
+func runJobs(ctx context.Context, videoFactory *factory.VideoServiceFactory, jobs []dto.BatchJob, sink progress.Sink, force bool) []dto.BatchJobResult {
+	results := make([]dto.BatchJobResult, 0, len(jobs))
+	for i, job := range jobs {
+		// 중단 요청(Ctrl-C)을 받으면 남은 작업은 실행하지 않고 취소로 기록
+		if ctx.Err() != nil {
+			err := fmt.Errorf("실행 취소됨: %w", ctx.Err())
+			results = append(results, dto.BatchJobResult{Job: job, Response: dto.VideoCreationResponse{Error: err}, Error: err})
+			continue
+		}
+
+		if !force {
+			if run := videoFactory.AlreadyProduced(ctx, job.Date, job.ServiceType); run != nil {
+				log.Printf("⏭️ 이미 생성됨 (%d/%d): 타입=%s, 날짜=%s, 파일=%s (다시 생성하려면 --force)", i+1, len(jobs), job.ServiceType, job.Date, run.OutputFile)
+				results = append(results, dto.BatchJobResult{
+					Job:      job,
+					Response: dto.VideoCreationResponse{FinalFileName: run.OutputFile, Success: true},
+					Success:  true,
+					Skipped:  true,
+				})
+				continue
+			}
+		}
+
+		log.Printf("📹 영상 생성 시작 (%d/%d): 타입=%s, 날짜=%s", i+1, len(jobs), job.ServiceType, job.Date)
+
+		// 작업별 제한 시간 적용
+		tracker := progress.NewTracker(job.ServiceType+" "+job.Date, sink)
+		jobCtx, cancel := context.WithTimeout(progress.WithTracker(ctx, tracker), config.JobTimeout())
+		tracker.Start()
+		response, err := videoFactory.CreateVideo(jobCtx, job.Date, job.ServiceType)
+		tracker.Finish(err)
+		cancel()
+		if err != nil {
+			log.Printf("❌ 영상 생성 실패: 타입=%s, 날짜=%s: %v", job.ServiceType, job.Date, err)
+		}
+
+		results = append(results, dto.BatchJobResult{
+			Job:      job,
+			Response: response,
+			Success:  err == nil,
+			Error:    err,
+			Duration: tracker.Elapsed(),
+			Stages:   tracker.Stages(),
+		})
+	}
+	return results
+}
+
+// printSummary - 배치 실행 결과를 표 형태로 출력합니다
+func printSummary(results []dto.BatchJobResult) {
+	succeeded, skipped := 0, 0
+	fmt.Println("\n📋 배치 실행 결과")
+	fmt.Println("==================================================")
+	fmt.Printf("%-6s %-10s %-6s %s\n", "TYPE", "DATE", "RESULT", "OUTPUT / ERROR")
+	for _, result := range results {
+		status := "OK"
+		detail := result.Response.FinalFileName
+		if result.Skipped {
+			status = "SKIP"
+			skipped++
+		} else if result.Success {
+			succeeded++
+		} else {
+			status = "FAIL"
+			detail = result.Error.Error()
+		}
+		fmt.Printf("%-6s %-10s %-6s %s\n", result.Job.ServiceType, result.Job.Date, status, detail)
+	}
+	fmt.Println("==================================================")
+	fmt.Printf("성공 %d건, 건너뜀 %d건, 실패 %d건 (전체 %d건)\n", succeeded, skipped, len(results)-succeeded-skipped, len(results))
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"auto-video-service/contentpack"
+	"auto-video-service/service"
+)
+
+// runImport - CSV/JSON/YAML 콘텐츠 팩을 검증하고 중복을 제외하여 DB에 저장합니다
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	dryRun := fs.Bool("dry-run", false, "검증과 중복 확인만 하고 저장하지 않음")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		log.Printf("에러: 가져올 파일 또는 디렉토리를 지정하세요 (예: import content/2026-10)")
+		return exitUsage
+	}
+
+	// 잘못된 행이 하나라도 있으면 아무것도 저장하지 않음
+	pack, err := contentpack.Load(fs.Args()...)
+	if err != nil {
+		log.Printf("❌ 콘텐츠 팩 검증 실패")
+		printJoinedErrors(err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	result, err := service.NewContentImportService().Import(ctx, pack, *dryRun)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	fmt.Printf("읽음:        %s\n", result.Loaded)
+	fmt.Printf("파일 내 중복: %s\n", result.Duplicates)
+	fmt.Printf("DB에 있음:   %s\n", result.Existing)
+	if *dryRun {
+		fmt.Printf("저장 예정:   %s (dry-run)\n", result.Imported)
+	} else {
+		fmt.Printf("✅ 저장:     %s\n", result.Imported)
+	}
+	return exitOK
+}
+
+// printJoinedErrors - errors.Join으로 묶인 에러를 한 줄에 하나씩 출력합니다
+func printJoinedErrors(err error) {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			printJoinedErrors(e)
+		}
+		return
+	}
+	fmt.Printf("  - %v\n", err)
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"auto-video-service/service"
+)
+
+// runListContent - 지정한 날짜에 각 서비스 타입이 사용할 콘텐츠를 출력합니다
+func runListContent(args []string) int {
+	fs := flag.NewFlagSet("list-content", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	date := fs.String("date", "", "조회할 날짜 (YYYYMMDD 또는 today, 기본값: config.yaml의 video.date)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *date == "" {
+		cliCfg, err := common.loadCliConfig()
+		if err != nil {
+			log.Printf("설정 파일을 읽는 중 에러 발생: %v", err)
+			return exitFailure
+		}
+		*date = cliCfg.Video.Date
+	}
+
+	targetDate, err := time.Parse("20060102", config.ResolveDate(*date, time.Now()))
+	if err != nil {
+		log.Printf("에러: 날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", *date)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	contentDataService := service.NewContentDataService()
+	contentTypes := []enum.ContentType{enum.ContentWord, enum.ContentIdiom, enum.ContentSentence}
+
+	fmt.Printf("📅 %s 콘텐츠 목록\n", targetDate.Format("2006-01-02"))
+
+	for _, contentType := range contentTypes {
+		result, err := contentDataService.GetShortsContentByContentType(ctx, targetDate, contentType)
+		printContent(fmt.Sprintf("숏폼 %s (인스타그램/페이스북)", contentType), result, err)
+	}
+
+	for _, contentType := range contentTypes {
+		result, err := contentDataService.GetYoutubeShortsContentByDate(ctx, targetDate, contentType)
+		printContent(fmt.Sprintf("유튜브 숏폼 %s", contentType), result, err)
+	}
+
+	title, longformWords, err := service.NewLongformWordService().GetTitleByDate(ctx, targetDate)
+	fmt.Println("\n▶ 유튜브 롱폼")
+	if err != nil {
+		fmt.Printf("  (없음: %v)\n", err)
+		return exitOK
+	}
+	fmt.Printf("  타이틀: %s / %s\n", title.Title, title.SubTitle)
+	for i, lw := range longformWords {
+		fmt.Printf("  %2d) %s (%s) [%s]\n", i+1, lw.Word, lw.Meaning, lw.PronunciationKr)
+	}
+	return exitOK
+}
+
+func printContent(label string, result *dto.ContentDataResult, err error) {
+	fmt.Printf("\n▶ %s\n", label)
+	if err != nil {
+		fmt.Printf("  (없음: %v)\n", err)
+		return
+	}
+	for i := range result.Primary {
+		english := result.Primary[i]
+		if i < len(result.PrimaryLine2) && result.PrimaryLine2[i] != "" {
+			english += " / " + result.PrimaryLine2[i]
+		}
+		korean := result.Secondary[i]
+		if i < len(result.SecondaryLine2) && result.SecondaryLine2[i] != "" {
+			korean += " / " + result.SecondaryLine2[i]
+		}
+		fmt.Printf("  %2d) %s (%s) [%s]\n", i+1, english, korean, result.Tertiary[i])
+	}
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/migration"
+)
+
+// runMigrate - 스키마 마이그레이션 (migrate up, migrate down, migrate status)
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		printMigrateUsage()
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "up":
+		return runMigrateUp(args[1:])
+	case "down":
+		return runMigrateDown(args[1:])
+	case "status":
+		return runMigrateStatus(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "알 수 없는 migrate 명령입니다: %s\n\n", args[0])
+		printMigrateUsage()
+		return exitUsage
+	}
+}
+
+func printMigrateUsage() {
+	fmt.Fprintln(os.Stderr, "사용법: auto-video-service migrate <up|down|status> [옵션]")
+	fmt.Fprintln(os.Stderr, "  up      적용되지 않은 마이그레이션을 모두 적용합니다")
+	fmt.Fprintln(os.Stderr, "  down    최근 마이그레이션을 되돌립니다 (테이블 삭제, --yes 필요)")
+	fmt.Fprintln(os.Stderr, "  status  마이그레이션별 적용 여부를 출력합니다")
+}
+
+func runMigrateUp(args []string) int {
+	fs := flag.NewFlagSet("migrate up", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	common.initDatabase()
+
+	ctx, stop := signalContext()
+	defer stop()
+	applied, err := migration.Up(ctx)
+	if err != nil {
+		log.Printf("❌ %v", err)
+		return exitCodeFor(err)
+	}
+	if len(applied) == 0 {
+		fmt.Println("적용할 마이그레이션이 없습니다 (최신 상태).")
+		return exitOK
+	}
+	fmt.Printf("✅ 마이그레이션 %d건 적용 완료 (%s)\n", len(applied), config.DatabaseDriver())
+	return exitOK
+}
+
+func runMigrateDown(args []string) int {
+	fs := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	steps := fs.Int("steps", 1, "되돌릴 마이그레이션 수 (최근 적용 순)")
+	yes := fs.Bool("yes", false, "테이블과 데이터가 삭제됨을 확인")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *steps < 1 {
+		log.Printf("에러: --steps는 1 이상이어야 합니다")
+		return exitUsage
+	}
+	if !*yes {
+		log.Printf("에러: migrate down은 테이블과 데이터를 삭제합니다. 계속하려면 --yes를 지정하세요")
+		return exitUsage
+	}
+
+	common.initDatabase()
+
+	ctx, stop := signalContext()
+	defer stop()
+	reverted, err := migration.Down(ctx, *steps)
+	if err != nil {
+		log.Printf("❌ %v", err)
+		return exitCodeFor(err)
+	}
+	fmt.Printf("✅ 마이그레이션 %d건 되돌림\n", len(reverted))
+	return exitOK
+}
+
+func runMigrateStatus(args []string) int {
+	fs := flag.NewFlagSet("migrate status", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	common.initDatabase()
+
+	ctx, stop := signalContext()
+	defer stop()
+	statuses, err := migration.Statuses(ctx)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	pending := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, s := range statuses {
+		status, appliedAt := "applied", s.AppliedAt.Format(time.DateTime)
+		if !s.Applied {
+			status, appliedAt = "pending", "-"
+			pending++
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, status, appliedAt)
+	}
+	w.Flush()
+	if pending > 0 {
+		fmt.Printf("\n적용되지 않은 마이그레이션 %d건 ('migrate up'으로 적용)\n", pending)
+	}
+	return exitOK
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+)
+
+// runPlan - 렌더링 없이 생성될 영상의 구성(콘텐츠, 슬라이드 텍스트, 클립 순서, 예상 길이, 파일명)을 출력합니다
+func runPlan(args []string) int {
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	var planOpts planOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	planOpts.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	return runPlanJobs(ctx, factory.NewVideoServiceFactory(), jobs, &planOpts)
+}
+
+// planOptions - plan 출력 형식
+type planOptions struct {
+	asJSON    bool
+	outputDir string
+}
+
+func (o *planOptions) register(fs *flag.FlagSet) {
+	fs.BoolVar(&o.asJSON, "json", false, "표 대신 JSON으로 출력")
+	fs.StringVar(&o.outputDir, "plan-out", "", "작업별 plan을 <디렉토리>/<타입>-<날짜>.plan.json으로 저장")
+}
+
+// runPlanJobs - 작업마다 plan을 계산하여 출력/저장합니다 (generate --dry-run과 공유)
+func runPlanJobs(ctx context.Context, videoFactory *factory.VideoServiceFactory, jobs []dto.BatchJob, opts *planOptions) int {
+	exitCode := exitOK
+	for _, job := range jobs {
+		plan, err := videoFactory.PlanVideo(ctx, job.Date, job.ServiceType)
+		if err != nil {
+			log.Printf("❌ plan 계산 실패: 타입=%s, 날짜=%s: %v", job.ServiceType, job.Date, err)
+			exitCode = exitCodeFor(err)
+			continue
+		}
+
+		if opts.asJSON {
+			data, _ := json.MarshalIndent(plan, "", "  ")
+			fmt.Println(string(data))
+		} else {
+			printPlan(plan)
+		}
+
+		if opts.outputDir != "" {
+			if err := writePlanFile(opts.outputDir, plan); err != nil {
+				log.Printf("에러: %v", err)
+				exitCode = exitFailure
+			}
+		}
+	}
+	return exitCode
+}
+
+func writePlanFile(dir string, plan dto.VideoPlan) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("plan 디렉토리 생성 실패: %w", err)
+	}
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.plan.json", plan.ServiceType, plan.TargetDate))
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("plan 저장 실패: %w", err)
+	}
+	log.Printf("📝 plan 저장: %s", path)
+	return nil
+}
+
+// printPlan - plan을 사람이 읽기 좋은 표로 출력합니다
+func printPlan(plan dto.VideoPlan) {
+	fmt.Printf("\n🗒️ %s %s → %s\n", plan.ServiceType, plan.TargetDate, plan.FinalFileName)
+	fmt.Println("==================================================")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "콘텐츠 (%d건)\n", len(plan.Contents))
+	for _, content := range plan.Contents {
+		primary := strings.TrimSpace(content.Primary + " " + content.PrimaryLine2)
+		secondary := strings.TrimSpace(content.Secondary + " " + content.SecondaryLine2)
+		fmt.Fprintf(w, "  %d)\t%s\t%s\t%s\n", content.Index, primary, secondary, content.Tertiary)
+	}
+
+	fmt.Fprintf(w, "\n슬라이드 (%d장)\n", len(plan.Slides))
+	for _, slide := range plan.Slides {
+		fmt.Fprintf(w, "  %s\t%s\n", slide.File, strings.Join(slide.Lines, " | "))
+	}
+
+	fmt.Fprintf(w, "\n클립 순서 (%d개)\n", len(plan.Clips))
+	for _, clip := range plan.Clips {
+		fmt.Fprintf(w, "  %3d\t%s\t%s\t%.1fs\t%s\n", clip.Order, clip.Kind, clip.File, clip.EstimatedSec, clip.Speech)
+	}
+	w.Flush()
+
+	fmt.Println("==================================================")
+	fmt.Printf("예상 길이: %s (추정값, 실제 TTS 길이에 따라 달라짐)\n", (time.Duration(plan.EstimatedSec * float64(time.Second))).Round(time.Second))
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/enum"
+	"auto-video-service/service"
+)
+
+// runPlanShorts - 날짜가 지정되지 않은 longform_words에 유튜브 숏폼 날짜(shorts_date)와 content_type을 배정합니다
+// 기본은 배정안만 출력하고, --apply를 지정하면 한 트랜잭션으로 저장합니다.
+func runPlanShorts(args []string) int {
+	fs := flag.NewFlagSet("plan-shorts", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	from := fs.String("from", "today", "배정 시작 날짜 (YYYYMMDD 또는 today)")
+	days := fs.Int("days", 14, "배정할 날짜 수")
+	perDay := fs.Int("per-day", 1, "날짜별, 콘텐츠 타입별 항목 수 (이미 지정된 항목 포함)")
+	gap := fs.Int("gap", 30, "같은 단어/숙어/문장을 다시 배정하기까지 최소 간격(일)")
+	types := fs.String("types", "word,idiom,sentence", "날짜마다 같은 수만큼 채울 콘텐츠 타입 (쉼표로 구분)")
+	apply := fs.Bool("apply", false, "배정안을 DB에 저장 (지정하지 않으면 미리보기만 출력)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	start, err := time.ParseInLocation("20060102", config.ResolveDate(*from, time.Now()), time.Local)
+	if err != nil {
+		log.Printf("에러: 날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", *from)
+		return exitUsage
+	}
+	rules := service.ShortsPlanRules{PerDay: *perDay, RepeatGapDays: *gap}
+	for _, t := range splitList(*types) {
+		switch contentType := enum.ContentType(t); contentType {
+		case enum.ContentWord, enum.ContentIdiom, enum.ContentSentence:
+			rules.ContentTypes = append(rules.ContentTypes, contentType)
+		default:
+			log.Printf("에러: types 값이 올바르지 않습니다 (입력값: %s). 허용된 값: word, idiom, sentence", t)
+			return exitUsage
+		}
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	planner := service.NewShortsPlannerService()
+	plan, err := planner.Plan(ctx, start, *days, rules)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+	printShortsPlan(plan)
+
+	if !*apply {
+		if len(plan.Assignments) > 0 {
+			fmt.Println("\n미리보기입니다. 저장하려면 --apply를 지정하세요.")
+		}
+		return exitOK
+	}
+	updated, err := planner.Apply(ctx, plan)
+	if err != nil {
+		log.Printf("❌ %v", err)
+		return exitCodeFor(err)
+	}
+	fmt.Printf("\n✅ 숏폼 일정 %d건 저장\n", updated)
+	return exitOK
+}
+
+// printShortsPlan - 배정안을 날짜/타입 순서의 표로 출력합니다
+func printShortsPlan(plan service.ShortsPlan) {
+	fmt.Printf("🗓️ 유튜브 숏폼 일정 %s ~ %s\n", plan.From, plan.To)
+	if len(plan.Assignments) == 0 {
+		fmt.Println("배정할 항목이 없습니다.")
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "SHORTS DATE\tTYPE\tID\tWORD\tMEANING")
+		for _, a := range plan.Assignments {
+			contentType := string(a.ContentType)
+			if a.Inferred {
+				contentType += " (추론)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", a.ShortsDate, contentType, a.Word.Id, a.Word.Word, a.Word.Meaning)
+		}
+		w.Flush()
+	}
+
+	if len(plan.Shortages) > 0 {
+		fmt.Println("\n⚠️ 후보가 부족한 날짜")
+		for _, s := range plan.Shortages {
+			fmt.Printf("  - %s %s: %d건 부족\n", s.Date, s.ContentType, s.Missing)
+		}
+	}
+	fmt.Printf("\n배정 %d건, 남은 미배정 후보 %d건\n", len(plan.Assignments), plan.Unassigned)
+}
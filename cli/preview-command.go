@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"auto-video-service/factory"
+)
+
+// runPreview - 음성/영상 없이 슬라이드 이미지만 생성하여 레이아웃을 빠르게 확인합니다
+func runPreview(args []string) int {
+	fs := flag.NewFlagSet("preview", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	outputDir := fs.String("out", "preview", "미리보기 이미지 출력 디렉토리")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	videoFactory := factory.NewVideoServiceFactory()
+
+	exitCode := exitOK
+	for _, job := range jobs {
+		// 날짜별 하위 디렉토리에 생성 (여러 날짜를 한 번에 미리볼 때 덮어쓰기 방지)
+		jobDir := filepath.Join(*outputDir, job.Date)
+		if err := os.MkdirAll(jobDir, 0755); err != nil {
+			log.Printf("미리보기 디렉토리 생성 실패: %v", err)
+			return exitFailure
+		}
+
+		if err := videoFactory.PreviewVideo(ctx, job.Date, job.ServiceType, jobDir); err != nil {
+			log.Printf("❌ 미리보기 생성 실패: 타입=%s, 날짜=%s: %v", job.ServiceType, job.Date, err)
+			exitCode = exitCodeFor(err)
+			continue
+		}
+		log.Printf("🖼️ 미리보기 생성 완료: 타입=%s, 날짜=%s → %s", job.ServiceType, job.Date, jobDir)
+	}
+	return exitCode
+}
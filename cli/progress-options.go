@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"auto-video-service/dto"
+	"auto-video-service/progress"
+	"auto-video-service/service"
+)
+
+// progressOptions - 진행 이벤트 출력 대상과 실행 보고서 옵션
+type progressOptions struct {
+	console    string
+	jsonlPath  string
+	callback   string
+	reportPath string
+}
+
+func (o *progressOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.console, "progress", "console", "콘솔 진행 표시 (console | none)")
+	fs.StringVar(&o.jsonlPath, "progress-jsonl", "", "진행 이벤트를 JSON Lines로 기록할 파일 (-이면 표준 출력)")
+	fs.StringVar(&o.callback, "progress-url", "", "진행 이벤트를 JSON으로 POST할 URL")
+	fs.StringVar(&o.reportPath, "report", "", "실행이 끝나면 생성 파일/재생 시간/단계별 소요 시간을 JSON으로 저장할 경로")
+}
+
+func (o *progressOptions) validate() error {
+	if o.console != "console" && o.console != "none" {
+		return fmt.Errorf("progress 값은 console 또는 none이어야 합니다 (입력값: %s)", o.console)
+	}
+	return nil
+}
+
+// sink - 옵션에 맞는 Sink를 만듭니다. 사용이 끝나면 Close를 호출해야 합니다.
+func (o *progressOptions) sink() (progress.Sink, error) {
+	sinks := progress.MultiSink{}
+	if o.console == "console" {
+		sinks = append(sinks, progress.NewConsoleSink(os.Stderr))
+	}
+	switch o.jsonlPath {
+	case "":
+	case "-":
+		sinks = append(sinks, progress.NewJSONLinesSink(os.Stdout))
+	default:
+		file, err := os.OpenFile(o.jsonlPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("진행 이벤트 파일 열기 실패: %w", err)
+		}
+		sinks = append(sinks, progress.NewJSONLinesSink(file))
+	}
+	if o.callback != "" {
+		sinks = append(sinks, progress.NewHTTPSink(o.callback))
+	}
+	return sinks, nil
+}
+
+// writeReport - 실행 결과를 JSON 보고서로 저장합니다 (경로를 지정하지 않으면 저장하지 않음)
+func (o *progressOptions) writeReport(ctx context.Context, startedAt time.Time, results []dto.BatchJobResult) error {
+	if o.reportPath == "" {
+		return nil
+	}
+
+	report := buildRunReport(ctx, startedAt, time.Now(), results)
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(o.reportPath); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("보고서 디렉토리 생성 실패: %w", err)
+		}
+	}
+	if err := os.WriteFile(o.reportPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("보고서 저장 실패: %w", err)
+	}
+	log.Printf("📝 실행 보고서 저장: %s", o.reportPath)
+	return nil
+}
+
+// buildRunReport - 작업 결과에 생성 파일 크기와 재생 시간을 붙여 보고서를 만듭니다
+func buildRunReport(ctx context.Context, startedAt time.Time, finishedAt time.Time, results []dto.BatchJobResult) dto.RunReport {
+	report := dto.RunReport{
+		StartedAt:   startedAt,
+		FinishedAt:  finishedAt,
+		DurationSec: finishedAt.Sub(startedAt).Seconds(),
+		Jobs:        make([]dto.JobReport, 0, len(results)),
+	}
+
+	for _, result := range results {
+		job := dto.JobReport{
+			Type:        result.Job.ServiceType,
+			Date:        result.Job.Date,
+			Success:     result.Success,
+			Skipped:     result.Skipped,
+			DurationSec: result.Duration.Seconds(),
+			Files:       make([]dto.ProducedFile, 0, 1),
+			Stages:      result.Stages,
+		}
+		if job.Stages == nil {
+			job.Stages = []dto.StageTiming{}
+		}
+
+		if result.Success {
+			if result.Skipped {
+				report.Skipped++
+			} else {
+				report.Succeeded++
+			}
+			if file, ok := describeProducedFile(ctx, result.Response.FinalFileName); ok {
+				job.Files = append(job.Files, file)
+			}
+		} else {
+			report.Failed++
+			if result.Error != nil {
+				job.Error = result.Error.Error()
+			}
+		}
+		report.Jobs = append(report.Jobs, job)
+	}
+	return report
+}
+
+func describeProducedFile(ctx context.Context, path string) (dto.ProducedFile, bool) {
+	info, err := os.Stat(path)
+	if path == "" || err != nil {
+		return dto.ProducedFile{}, false
+	}
+
+	file := dto.ProducedFile{Path: path, Size: info.Size()}
+	if duration, err := service.MediaDuration(ctx, path); err != nil {
+		log.Printf("재생 시간 조회 실패 (%s): %v", path, err)
+	} else {
+		file.DurationSec = duration
+	}
+	return file, true
+}
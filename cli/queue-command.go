@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/enum"
+	"auto-video-service/factory"
+	"auto-video-service/repository"
+	"auto-video-service/worker"
+)
+
+// runEnqueue - 작업을 DB 대기열(generation_jobs)에 등록합니다. 실행은 worker가 담당합니다.
+func runEnqueue(args []string) int {
+	fs := flag.NewFlagSet("enqueue", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ids, err := worker.Enqueue(context.Background(), jobs, nil)
+	for i, id := range ids {
+		fmt.Printf("#%d 등록: 타입=%s, 날짜=%s\n", id, jobs[i].ServiceType, jobs[i].Date)
+	}
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// runWorker - DB 대기열의 작업을 가져와 실행하는 worker를 실행합니다 (Ctrl-C로 종료)
+func runWorker(args []string) int {
+	fs := flag.NewFlagSet("worker", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	id := fs.String("id", "", "worker 식별자 (기본값: <hostname>-<pid>)")
+	poll := fs.Duration("poll", 0, "대기열 조회 주기 (기본값: config.json의 Queue.PollInterval 또는 5s)")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if err := registerProfiles(common.profiles()); err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+	if *poll > 0 {
+		config.Config.Queue.PollInterval = poll.String()
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	if err := worker.NewWorker(*id, factory.NewVideoServiceFactory()).Run(ctx); err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// runJobList - DB 대기열의 작업 목록을 출력합니다
+func runJobList(args []string) int {
+	fs := flag.NewFlagSet("jobs", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	status := fs.String("status", "", "상태로 필터링 (queued, running, done, failed)")
+	limit := fs.Int("limit", 20, "출력할 최대 작업 수")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx := context.Background()
+	jobs, err := repository.GenerationJobRepository().FindRecent(ctx, *status, *limit)
+	if err != nil {
+		log.Printf("에러: 작업 목록 조회 실패: %v", err)
+		return exitFailure
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTYPE\tDATE\tSTATUS\tATTEMPTS\tNEXT RUN\tOUTPUT / ERROR")
+	for _, job := range jobs {
+		detail := job.OutputFile
+		if job.LastError != "" && job.Status != string(enum.JobDone) {
+			detail = job.LastError
+		}
+		nextRun := "-"
+		if job.Status == string(enum.JobQueued) {
+			nextRun = job.AvailableAt.Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d/%d\t%s\t%s\n", job.Id, job.ServiceType, job.TargetDate, job.Status, job.Attempts, job.MaxAttempts, nextRun, detail)
+	}
+	w.Flush()
+	return exitOK
+}
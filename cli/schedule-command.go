@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"auto-video-service/factory"
+	"auto-video-service/scheduler"
+)
+
+// runSchedule - config.yaml의 schedules 일정에 따라 영상을 생성하거나 대기열에 등록합니다 (Ctrl-C로 종료)
+func runSchedule(args []string) int {
+	fs := flag.NewFlagSet("schedule", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	list := fs.Bool("list", false, "실행하지 않고 항목별 마지막 성공/다음 실행 시각만 출력")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	cliCfg, err := common.loadCliConfig()
+	if err != nil {
+		log.Printf("에러: 설정 파일을 읽는 중 에러 발생: %v", err)
+		return exitFailure
+	}
+	sched, err := scheduler.NewScheduler(cliCfg.Schedules, factory.NewVideoServiceFactory())
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	if *list {
+		statuses, err := sched.Statuses(ctx, time.Now())
+		if err != nil {
+			log.Printf("에러: %v", err)
+			return exitFailure
+		}
+		printScheduleStatuses(statuses)
+		return exitOK
+	}
+
+	if err := sched.Run(ctx); err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+func printScheduleStatuses(statuses []scheduler.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCRON\tTYPES\tMODE\tLAST SUCCESS\tNEXT RUN\tCATCH-UP")
+	for _, status := range statuses {
+		lastRun := "-"
+		if !status.LastRunAt.IsZero() {
+			lastRun = status.LastRunAt.Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\n", status.Name, status.Cron, strings.Join(status.Types, ","), status.Mode, lastRun, status.NextRunAt.Format(time.DateTime), len(status.Missed))
+	}
+	w.Flush()
+}
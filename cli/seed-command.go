@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"auto-video-service/config"
+	"auto-video-service/migration"
+	"auto-video-service/seed"
+	"auto-video-service/service"
+)
+
+// runSeed - 마이그레이션을 적용하고 샘플 콘텐츠 1주일을 DB에 저장합니다 (새 개발 환경에서 바로 영상 생성 가능)
+func runSeed(args []string) int {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	from := fs.String("from", "today", "샘플 콘텐츠 시작 날짜 (YYYYMMDD 또는 today)")
+	dryRun := fs.Bool("dry-run", false, "저장하지 않고 저장될 건수만 출력")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	start, err := time.ParseInLocation("20060102", config.ResolveDate(*from, time.Now()), time.Local)
+	if err != nil {
+		log.Printf("에러: 날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", *from)
+		return exitUsage
+	}
+	pack, err := seed.SampleWeek(start)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+	if *dryRun {
+		if err := common.initEnvironment(); err != nil {
+			log.Printf("에러: %v", err)
+			return exitCodeFor(err)
+		}
+	} else {
+		// seed는 적용되지 않은 마이그레이션이 있어도 먼저 모두 적용
+		common.initDatabase()
+		if _, err := migration.Up(ctx); err != nil {
+			log.Printf("❌ %v", err)
+			return exitCodeFor(err)
+		}
+	}
+
+	// 이미 있는 콘텐츠는 건너뛰므로 여러 번 실행해도 중복 저장되지 않음
+	result, err := service.NewContentImportService().Import(ctx, pack, *dryRun)
+	if err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	end := start.AddDate(0, 0, seed.SampleDays-1)
+	fmt.Printf("🌱 샘플 콘텐츠 %s ~ %s\n", start.Format("20060102"), end.Format("20060102"))
+	fmt.Printf("DB에 있음: %s\n", result.Existing)
+	if *dryRun {
+		fmt.Printf("저장 예정: %s (dry-run)\n", result.Imported)
+		return exitOK
+	}
+	fmt.Printf("✅ 저장:   %s\n", result.Imported)
+	fmt.Printf("\n예) go run . generate --type iw,yl --date %s\n", start.Format("20060102"))
+	return exitOK
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"flag"
+	"log"
+
+	"auto-video-service/config"
+	"auto-video-service/factory"
+	"auto-video-service/server"
+)
+
+const defaultHttpPort = "8080"
+
+// runServe - REST API 서버를 실행합니다 (작업 등록/상태 조회/결과 다운로드)
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	var common commonOptions
+	common.register(fs)
+	addr := fs.String("addr", "", "수신 주소 (기본값: 127.0.0.1:<config.json의 HttpPort, 없으면 8080>). 외부 주소는 ApiToken 필요")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if err := registerProfiles(common.profiles()); err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	if err := common.initEnvironment(); err != nil {
+		log.Printf("에러: %v", err)
+		return exitCodeFor(err)
+	}
+
+	if *addr == "" {
+		port := config.Config.HttpPort
+		if port == "" {
+			port = defaultHttpPort
+		}
+		*addr = "127.0.0.1:" + port
+	}
+	// 작업 등록은 렌더링을 실행하므로 인증 없이 외부에 열지 않음
+	if !server.IsLoopbackAddr(*addr) && config.Config.ApiToken == "" {
+		log.Printf("에러: %s는 외부에서 접근할 수 있는 주소입니다. config.json에 ApiToken을 지정하거나 127.0.0.1 주소로 실행하세요", *addr)
+		return exitUsage
+	}
+
+	ctx, stop := signalContext()
+	defer stop()
+
+	apiServer := server.NewServer(factory.NewVideoServiceFactory())
+	if err := apiServer.ListenAndServe(ctx, *addr); err != nil {
+		log.Printf("에러: %v", err)
+		return exitFailure
+	}
+	return exitOK
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"auto-video-service/factory"
+	"auto-video-service/scheduler"
+)
+
+// runValidate - 설정 파일과 플래그를 해석하여 실행될 작업 목록을 검증하고 출력합니다 (렌더링/DB 연결 없음)
+func runValidate(args []string) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	var common commonOptions
+	var jobOpts jobOptions
+	common.register(fs)
+	jobOpts.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	jobs, err := resolveJobs(&common, &jobOpts)
+	if err != nil {
+		log.Printf("❌ 검증 실패: %v", err)
+		return exitFailure
+	}
+
+	fmt.Printf("✅ 설정 파일 검증 완료: %s (작업 %d건)\n", common.configPath, len(jobs))
+	for i, job := range jobs {
+		fmt.Printf("%3d) 타입=%-6s 날짜=%s\n", i+1, job.ServiceType, job.Date)
+	}
+
+	// schedules 항목이 있으면 cron 표현식과 서비스 타입도 검사
+	cliCfg, err := common.loadCliConfig()
+	if err != nil {
+		log.Printf("❌ 검증 실패: %v", err)
+		return exitFailure
+	}
+	if len(cliCfg.Schedules) > 0 {
+		if _, err := scheduler.NewScheduler(cliCfg.Schedules, factory.NewVideoServiceFactory()); err != nil {
+			log.Printf("❌ 검증 실패: %v", err)
+			return exitFailure
+		}
+		fmt.Printf("✅ schedules 검증 완료 (%d건)\n", len(cliCfg.Schedules))
+	}
+	return exitOK
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 캐시 기본값 (config.json의 Cache 항목이 비어있을 때 사용)
+const (
+	defaultCacheDir     = "cache"
+	defaultCacheMaxSize = 2 << 30 // 2GB
+)
+
+// CacheDir - 음성/이미지/클립 캐시 디렉토리. 캐시를 끈 경우 빈 문자열을 반환합니다.
+func CacheDir() string {
+	if Config.Cache.Disabled {
+		return ""
+	}
+	if Config.Paths.CacheDir != "" {
+		return Config.Paths.CacheDir
+	}
+	return defaultCacheDir
+}
+
+// CacheMaxSize - cache prune 시 남겨둘 최대 용량 (바이트)
+func CacheMaxSize() int64 {
+	if Config.Cache.MaxSize == "" {
+		return defaultCacheMaxSize
+	}
+	size, err := ParseSize(Config.Cache.MaxSize)
+	if err != nil {
+		log.Printf("Cache.MaxSize 값이 올바르지 않아 기본값(2GB)을 사용합니다: %q", Config.Cache.MaxSize)
+		return defaultCacheMaxSize
+	}
+	return size
+}
+
+// CacheMaxAge - cache prune 시 마지막 사용 후 보관 기간 (0이면 기간 제한 없음)
+func CacheMaxAge() time.Duration {
+	if Config.Cache.MaxAge == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(Config.Cache.MaxAge)
+	if err != nil || d < 0 {
+		log.Printf("Cache.MaxAge 값이 올바르지 않아 기간 제한 없이 정리합니다: %q", Config.Cache.MaxAge)
+		return 0
+	}
+	return d
+}
+
+// ParseSize - "500MB", "2GB", "1024" 형식의 용량을 바이트로 변환합니다
+func ParseSize(value string) (int64, error) {
+	units := []struct {
+		suffix string
+		scale  int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	}
+
+	text := strings.ToUpper(strings.TrimSpace(value))
+	scale := int64(1)
+	for _, unit := range units {
+		if strings.HasSuffix(text, unit.suffix) {
+			text = strings.TrimSpace(strings.TrimSuffix(text, unit.suffix))
+			scale = unit.scale
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("용량 형식이 올바르지 않습니다: %q", value)
+	}
+	return int64(number * float64(scale)), nil
+}
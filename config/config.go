@@ -15,6 +15,7 @@ const (
 
 var Config = struct {
 	HttpPort    string
+	ApiToken    string // serve API 인증 토큰 (Authorization: Bearer). 비우면 127.0.0.1 주소에서만 serve 실행
 	Environment string
 	Database    struct {
 		Driver           string
@@ -28,13 +29,22 @@ var Config = struct {
 	FontPath       string
 	BoldFontPath   string
 	TitleFontPath  string
+	// PhoneticFontPath - IPA 발음기호 보조 폰트. Fonts.Regular 목록 끝에 붙음 (FontPath에 없는 ə, ɪ, ʃ 등)
+	PhoneticFontPath string
+	// Fonts - 역할별 보조 폰트 목록 (순서대로 시도). 기본 폰트에 없는 글자만 앞에서부터 glyph가 있는 폰트로 그림
+	Fonts struct {
+		Regular []string // FontPath 뒤
+		Bold    []string // BoldFontPath 뒤
+		Title   []string // TitleFontPath 뒤
+	}
 	StartAudioPath string
 	Paths struct {
-		TempDir       string
-		TempImagesDir string
-		TempAudioDir  string
-		TempVideosDir string
+		TempDir       string // 작업별 임시 디렉토리(Workspace)가 생성되는 기준 디렉토리
+		TempImagesDir string // Deprecated: 작업별 Workspace.ImagesDir 사용
+		TempAudioDir  string // Deprecated: 작업별 Workspace.AudioDir 사용
+		TempVideosDir string // Deprecated: 작업별 Workspace.VideosDir 사용
 		FinalVideoDir string
+		CacheDir      string // 음성/이미지/클립 캐시 디렉토리 (기본값: cache)
 		TemplateDir   string
 		Templates     struct {
 			Vertical      string
@@ -45,6 +55,36 @@ var Config = struct {
 			StartComment  string
 		}
 	}
+	Timeouts struct {
+		Job    string
+		FFmpeg string
+		Say    string
+		GTTS   string
+	}
+	Queue struct {
+		PollInterval string // 대기 작업 조회 주기 (기본값: 5s)
+		MaxAttempts  int    // 작업당 최대 시도 횟수 (기본값: 3)
+		RetryBackoff string // 첫 재시도 대기 시간, 이후 2배씩 증가 (기본값: 1m)
+	}
+	Content struct {
+		Source  string // 콘텐츠 조회 출처: db(기본값) | file (PackDir의 CSV/JSON/YAML 콘텐츠 팩)
+		PackDir string // Source가 file일 때 콘텐츠 팩 디렉토리 (기본값: content)
+		// 생성 전 중복 콘텐츠 경고의 검사 기간(일, 대상 날짜 앞뒤). 0이면 기본값 30, 음수면 경고하지 않음
+		DuplicateWindowDays int
+		// 영상 안 항목 순서: sort_order(기본값, sort_order → id 순) | shuffle (ShuffleSeed + 날짜로 섞어 항상 같은 순서)
+		Order       string
+		ShuffleSeed int64
+		// true면 렌더링 전 콘텐츠 검증 오류(빈 발음, 슬라이드에 들어가지 않는 문장 등)가 있어도 생성 (generate --allow-invalid)
+		AllowInvalid bool
+	}
+	Render struct {
+		Workers int // 항목(단어/문장)별 음성·클립 동시 생성 수. 0이면 CPU 수 기준 기본값
+	}
+	Cache struct {
+		Disabled bool   // true면 캐시를 사용하지 않음
+		MaxSize  string // cache prune 기본 최대 용량 (예: "2GB", "500MB")
+		MaxAge   string // cache prune 기본 보관 기간 (예: "720h"). 비우면 기간 제한 없음
+	}
 	VideoMetadata struct {
 		Title       string
 		Description string
@@ -62,6 +102,8 @@ type CliConfig struct {
 		Type string `yaml:"type"`
 		Date string `yaml:"date"`
 	} `yaml:"video"`
+	Jobs      []JobConfig      `yaml:"jobs"`
+	Schedules []ScheduleConfig `yaml:"schedules"`
 }
 
 // LoadCliConfig reads the config.yaml file and returns the configuration.
@@ -0,0 +1,47 @@
+package config
+
+// 콘텐츠 조회 출처 (config.json의 Content.Source)
+const (
+	ContentSourceDB   = "db"
+	ContentSourceFile = "file"
+
+	defaultContentPackDir = "content"
+
+	defaultDuplicateWindowDays = 30
+)
+
+// 영상 안 항목 순서 (config.json의 Content.Order)
+const (
+	ContentOrderSortOrder = "sort_order"
+	ContentOrderShuffle   = "shuffle"
+)
+
+// ContentFromFiles - DB 대신 콘텐츠 팩 파일에서 콘텐츠를 조회하는지 여부
+func ContentFromFiles() bool {
+	return Config.Content.Source == ContentSourceFile
+}
+
+// ContentPackDir - 콘텐츠 팩 디렉토리 (Content.PackDir, 기본값 content)
+func ContentPackDir() string {
+	if Config.Content.PackDir != "" {
+		return Config.Content.PackDir
+	}
+	return defaultContentPackDir
+}
+
+// DuplicateWindowDays - 생성 전 중복 콘텐츠 경고의 검사 기간(일). 0이면 경고하지 않음
+func DuplicateWindowDays() int {
+	switch days := Config.Content.DuplicateWindowDays; {
+	case days == 0:
+		return defaultDuplicateWindowDays
+	case days < 0:
+		return 0
+	default:
+		return days
+	}
+}
+
+// ContentShuffled - 항목 순서를 시드 기반으로 섞는지 여부 (Content.Order가 shuffle)
+func ContentShuffled() bool {
+	return Config.Content.Order == ContentOrderShuffle
+}
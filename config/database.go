@@ -3,14 +3,26 @@ package config
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/go-xorm/xorm"
+	_ "github.com/mattn/go-sqlite3"
 	"xorm.io/core"
 )
 
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite3"
+
+	// defaultSQLitePath - Driver가 sqlite3이고 ConnectionString이 비어있을 때 사용하는 DB 파일
+	defaultSQLitePath = "data/auto-video.db"
+)
+
 var (
 	xormDb     *xorm.Engine
 	dbOnce     sync.Once
@@ -20,17 +32,27 @@ var (
 // ConfigureDatabase - 데이터베이스 연결을 초기화합니다 (한 번만 실행됨)
 func ConfigureDatabase() DatabaseWrapper {
 	dbOnce.Do(func() {
+		driver := DatabaseDriver()
 		dbConnection := Config.Database.ConnectionString
+		if driver == DriverSQLite {
+			dbConnection = sqliteSource(dbConnection)
+		}
 
-		engine, err := xorm.NewEngine(Config.Database.Driver, dbConnection)
+		engine, err := xorm.NewEngine(driver, dbConnection)
 		if err != nil {
 			panic(fmt.Errorf("데이터베이스 연결 오류: %w", err))
 		}
 		fmt.Println("DB connected: ", Config.Database.Connection)
 
-		engine.SetMaxOpenConns(10)
-		engine.SetMaxIdleConns(5)
-		engine.SetConnMaxLifetime(10 * time.Minute)
+		if driver == DriverSQLite {
+			// SQLite는 쓰기 잠금이 DB 단위라 연결 하나로 직렬화 (:memory:는 연결마다 별도 DB이므로 연결을 유지)
+			engine.SetMaxOpenConns(1)
+			engine.SetMaxIdleConns(1)
+		} else {
+			engine.SetMaxOpenConns(10)
+			engine.SetMaxIdleConns(5)
+			engine.SetConnMaxLifetime(10 * time.Minute)
+		}
 		engine.Logger().SetLevel(core.LOG_INFO)
 
 		xormDb = engine
@@ -40,6 +62,43 @@ func ConfigureDatabase() DatabaseWrapper {
 	return dbInstance
 }
 
+// DatabaseDriver - config.json의 Database.Driver ("sqlite"는 sqlite3으로 취급)
+func DatabaseDriver() string {
+	if Config.Database.Driver == "sqlite" {
+		return DriverSQLite
+	}
+	return Config.Database.Driver
+}
+
+// IsSQLite - 내장 SQLite 백엔드(오프라인 실행, 테스트)를 사용하는지 여부
+func IsSQLite() bool {
+	return DatabaseDriver() == DriverSQLite
+}
+
+// sqliteSource - SQLite 접속 문자열을 만듭니다. 파일 경로면 상위 디렉토리를 만들고 잠금 대기 시간을 지정합니다.
+func sqliteSource(source string) string {
+	if source == "" {
+		source = defaultSQLitePath
+	}
+	if source == ":memory:" || strings.HasPrefix(source, "file:") {
+		return source
+	}
+	if dir := filepath.Dir(source); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			panic(fmt.Errorf("SQLite 디렉토리 생성 오류: %w", err))
+		}
+	}
+	if !strings.Contains(source, "?") {
+		source += "?_busy_timeout=5000"
+	}
+	return source
+}
+
+// DatabaseConfigured - ConfigureDatabase가 호출되었는지 여부 (DB 없이 실행되는 경로에서 선택적 기록에 사용)
+func DatabaseConfigured() bool {
+	return xormDb != nil
+}
+
 // GetDatabase - 이미 초기화된 데이터베이스 연결을 반환합니다
 func GetDatabase() DatabaseWrapper {
 	if xormDb == nil {
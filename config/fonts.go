@@ -0,0 +1,37 @@
+package config
+
+import "slices"
+
+// FontRole - 이미지에 글자를 그리는 폰트 용도
+// 역할마다 기본 폰트(FontPath/BoldFontPath/TitleFontPath) 뒤에 Fonts의 보조 폰트를 순서대로 붙이고,
+// 글자마다 glyph가 있는 첫 번째 폰트로 그립니다 (IPA, 둥근 따옴표, 기호, CJK 문장부호 등).
+type FontRole string
+
+const (
+	FontRoleRegular FontRole = "regular" // FontPath - 숏폼 슬라이드, 영어/한국어 이미지, 단어 수
+	FontRoleBold    FontRole = "bold"    // BoldFontPath - 롱폼 본문 슬라이드, 타이틀의 서브타이틀
+	FontRoleTitle   FontRole = "title"   // TitleFontPath - 롱폼 타이틀
+)
+
+// FontChain - 역할의 폰트 경로 목록. 첫 번째는 항상 기본 폰트이고, 빈 경로와 중복은 제외합니다
+// PhoneticFontPath는 Fonts.Regular 뒤에 붙습니다.
+func FontChain(role FontRole) []string {
+	var primary string
+	var fallbacks []string
+	switch role {
+	case FontRoleBold:
+		primary, fallbacks = Config.BoldFontPath, Config.Fonts.Bold
+	case FontRoleTitle:
+		primary, fallbacks = Config.TitleFontPath, Config.Fonts.Title
+	default:
+		primary, fallbacks = Config.FontPath, append(slices.Clone(Config.Fonts.Regular), Config.PhoneticFontPath)
+	}
+
+	chain := []string{primary}
+	for _, path := range fallbacks {
+		if path != "" && !slices.Contains(chain, path) {
+			chain = append(chain, path)
+		}
+	}
+	return chain
+}
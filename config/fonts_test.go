@@ -0,0 +1,48 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFontChain(t *testing.T) {
+	saved := Config
+	t.Cleanup(func() { Config = saved })
+	Config.FontPath = "regular.ttf"
+	Config.BoldFontPath = "bold.ttf"
+	Config.TitleFontPath = "title.ttf"
+	Config.PhoneticFontPath = "ipa.ttf"
+
+	tests := []struct {
+		name    string
+		role    FontRole
+		regular []string
+		bold    []string
+		want    []string
+	}{
+		{"regular appends phonetic last", FontRoleRegular, []string{"noto.ttf", "cjk.ttf"}, nil, []string{"regular.ttf", "noto.ttf", "cjk.ttf", "ipa.ttf"}},
+		{"empty and duplicate paths removed", FontRoleRegular, []string{"", "regular.ttf", "noto.ttf", "noto.ttf"}, nil, []string{"regular.ttf", "noto.ttf", "ipa.ttf"}},
+		{"phonetic already listed keeps its position", FontRoleRegular, []string{"ipa.ttf", "noto.ttf"}, nil, []string{"regular.ttf", "ipa.ttf", "noto.ttf"}},
+		{"bold has no phonetic", FontRoleBold, []string{"noto.ttf"}, []string{"noto-bold.ttf"}, []string{"bold.ttf", "noto-bold.ttf"}},
+		{"title without fallbacks", FontRoleTitle, []string{"noto.ttf"}, nil, []string{"title.ttf"}},
+		{"unknown role uses regular", FontRole("x"), nil, nil, []string{"regular.ttf", "ipa.ttf"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Config.Fonts.Regular = tt.regular
+			Config.Fonts.Bold = tt.bold
+			Config.Fonts.Title = nil
+			if got := FontChain(tt.role); !slices.Equal(got, tt.want) {
+				t.Errorf("FontChain(%s) = %v, want %v", tt.role, got, tt.want)
+			}
+		})
+	}
+
+	// Fonts.Regular 원본은 바뀌지 않음 (PhoneticFontPath를 붙일 때 복사)
+	Config.Fonts.Regular = make([]string, 1, 4)
+	Config.Fonts.Regular[0] = "noto.ttf"
+	FontChain(FontRoleRegular)
+	if full := Config.Fonts.Regular[:cap(Config.Fonts.Regular)]; full[1] != "" {
+		t.Errorf("FontChain modified Fonts.Regular backing array: %v", full)
+	}
+}
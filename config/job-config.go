@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"auto-video-service/dto"
+)
+
+// JobConfig - 배치 실행 시 하나의 작업 항목 (jobs 목록의 원소)
+// type/types 중 하나 이상, date 또는 from/to 중 하나를 지정합니다.
+type JobConfig struct {
+	Type  string   `yaml:"type"`
+	Types []string `yaml:"types"`
+	Date  string   `yaml:"date"`
+	From  string   `yaml:"from"`
+	To    string   `yaml:"to"`
+}
+
+// ResolveDate - 'today'이거나 비어있으면 오늘 날짜(YYYYMMDD)로 치환합니다
+func ResolveDate(date string, now time.Time) string {
+	if date == "today" || date == "" {
+		return now.Format("20060102")
+	}
+	return date
+}
+
+// ExpandJobs - jobs 목록을 (타입, 날짜) 단위의 배치 작업으로 펼칩니다.
+// jobs가 비어있으면 video.type / video.date 한 건을 작업으로 반환합니다.
+func (c *CliConfig) ExpandJobs(now time.Time) ([]dto.BatchJob, error) {
+	if len(c.Jobs) == 0 {
+		return []dto.BatchJob{{
+			ServiceType: c.Video.Type,
+			Date:        ResolveDate(c.Video.Date, now),
+		}}, nil
+	}
+
+	jobs := make([]dto.BatchJob, 0)
+	for i, jc := range c.Jobs {
+		types := jc.Types
+		if jc.Type != "" {
+			types = append([]string{jc.Type}, types...)
+		}
+		if len(types) == 0 {
+			return nil, fmt.Errorf("jobs[%d]: type 또는 types를 지정해야 합니다", i)
+		}
+
+		dates, err := jc.dates(now)
+		if err != nil {
+			return nil, fmt.Errorf("jobs[%d]: %w", i, err)
+		}
+
+		for _, date := range dates {
+			for _, serviceType := range types {
+				jobs = append(jobs, dto.BatchJob{ServiceType: serviceType, Date: date})
+			}
+		}
+	}
+
+	return jobs, nil
+}
+
+// dates - date 또는 from/to 범위를 YYYYMMDD 목록으로 변환합니다
+func (jc JobConfig) dates(now time.Time) ([]string, error) {
+	if jc.From == "" && jc.To == "" {
+		return []string{ResolveDate(jc.Date, now)}, nil
+	}
+	if jc.Date != "" {
+		return nil, fmt.Errorf("date와 from/to는 함께 사용할 수 없습니다")
+	}
+
+	from, err := time.Parse("20060102", ResolveDate(jc.From, now))
+	if err != nil {
+		return nil, fmt.Errorf("from 날짜 형식이 잘못되었습니다 (입력값: %s)", jc.From)
+	}
+	to, err := time.Parse("20060102", ResolveDate(jc.To, now))
+	if err != nil {
+		return nil, fmt.Errorf("to 날짜 형식이 잘못되었습니다 (입력값: %s)", jc.To)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("to(%s)가 from(%s)보다 이전입니다", jc.To, jc.From)
+	}
+
+	dates := make([]string, 0)
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d.Format("20060102"))
+	}
+	return dates, nil
+}
@@ -0,0 +1,150 @@
+package config
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"auto-video-service/enum"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultProfilesYAML - 바이너리에 포함된 기본 플랫폼 프로필 (config/profiles.yaml)
+//
+//go:embed profiles.yaml
+var defaultProfilesYAML []byte
+
+const defaultProfileFontSize = 120.0
+
+// PlatformProfile - 숏폼 채널 하나의 생성 규칙 (profiles.yaml의 profiles 항목)
+type PlatformProfile struct {
+	Code          string                  `yaml:"code"`
+	Description   string                  `yaml:"description"`
+	Platform      enum.Platform           `yaml:"platform"`
+	ContentType   enum.ContentType        `yaml:"content_type"`
+	ContentSource enum.ContentSource      `yaml:"content_source"`
+	FileName      string                  `yaml:"file_name"`
+	Template      string                  `yaml:"template"` // 비우면 Paths.Templates.Vertical
+	TextColor     enum.TextColor          `yaml:"text_color"`
+	FontSize      float64                 `yaml:"font_size"`
+	Pronunciation enum.PronunciationStyle `yaml:"pronunciation"` // 영어 슬라이드의 발음 줄: kr(기본값) | ipa | both
+	Options       ProfileOptions          `yaml:"options"`
+}
+
+// ProfileOptions - 음성/영상 속도와 반복 설정
+type ProfileOptions struct {
+	EnglishRepeatCount int     `yaml:"english_repeat_count"`
+	SpeakSpeed         float64 `yaml:"speak_speed"`
+	PauseDuration      float64 `yaml:"pause_duration"`
+	IsReverse          bool    `yaml:"is_reverse"`
+}
+
+type profilesFile struct {
+	Profiles []PlatformProfile `yaml:"profiles"`
+}
+
+// LoadProfiles - 프로필 파일을 읽어 검증합니다. 파일이 없으면 기본 프로필을 사용합니다.
+func LoadProfiles(path string) ([]PlatformProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("프로필 파일을 읽을 수 없습니다: %w", err)
+		}
+		data = defaultProfilesYAML
+	}
+	return ParseProfiles(data)
+}
+
+// ParseProfiles - YAML 프로필을 해석하고 기본값 적용 및 검증을 수행합니다
+func ParseProfiles(data []byte) ([]PlatformProfile, error) {
+	var file profilesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("프로필 파일 형식이 올바르지 않습니다: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for i := range file.Profiles {
+		p := &file.Profiles[i]
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("profiles[%d]: %w", i, err)
+		}
+		if seen[p.Code] {
+			return nil, fmt.Errorf("profiles[%d]: 중복된 code입니다: %s", i, p.Code)
+		}
+		seen[p.Code] = true
+		p.applyDefaults()
+	}
+	return file.Profiles, nil
+}
+
+// TemplatePath - 프로필의 배경 템플릿 경로
+func (p PlatformProfile) TemplatePath() string {
+	if p.Template != "" {
+		return p.Template
+	}
+	return Config.Paths.Templates.Vertical
+}
+
+func (p *PlatformProfile) applyDefaults() {
+	if p.Description == "" {
+		p.Description = p.Code
+	}
+	if p.TextColor == "" {
+		p.TextColor = enum.TextColorBeige
+	}
+	if p.FontSize == 0 {
+		p.FontSize = defaultProfileFontSize
+	}
+	if p.Pronunciation == "" {
+		p.Pronunciation = enum.PronunciationKorean
+	}
+	if p.Options.EnglishRepeatCount == 0 {
+		p.Options.EnglishRepeatCount = 1
+	}
+	if p.Options.SpeakSpeed == 0 {
+		p.Options.SpeakSpeed = 1.0
+	}
+}
+
+func (p *PlatformProfile) validate() error {
+	if p.Code == "" {
+		return fmt.Errorf("code는 필수입니다")
+	}
+	// platform은 표시/분류용이므로 새 채널을 코드 수정 없이 추가할 수 있도록 값 자체는 제한하지 않음
+	if p.Platform == "" {
+		return fmt.Errorf("%s: platform은 필수입니다", p.Code)
+	}
+	switch p.ContentType {
+	case enum.ContentWord, enum.ContentIdiom, enum.ContentSentence:
+	default:
+		return fmt.Errorf("%s: content_type 값이 올바르지 않습니다: %q", p.Code, p.ContentType)
+	}
+	switch p.ContentSource {
+	case enum.ContentSourceShorts, enum.ContentSourceYoutubeShorts:
+	default:
+		return fmt.Errorf("%s: content_source 값이 올바르지 않습니다: %q", p.Code, p.ContentSource)
+	}
+	switch p.TextColor {
+	case "", enum.TextColorWhite, enum.TextColorBlack, enum.TextColorBeige:
+	default:
+		return fmt.Errorf("%s: text_color 값이 올바르지 않습니다: %q", p.Code, p.TextColor)
+	}
+	switch p.Pronunciation {
+	case "", enum.PronunciationKorean:
+	case enum.PronunciationIPA, enum.PronunciationBoth:
+		// short_sentences에는 phonetic_symbol 컬럼이 없음
+		if p.ContentSource == enum.ContentSourceShorts && p.ContentType == enum.ContentSentence {
+			return fmt.Errorf("%s: 문장(short_sentences)에는 IPA 발음기호가 없어 pronunciation: %s를 사용할 수 없습니다", p.Code, p.Pronunciation)
+		}
+	default:
+		return fmt.Errorf("%s: pronunciation 값이 올바르지 않습니다: %q", p.Code, p.Pronunciation)
+	}
+	if p.FileName == "" {
+		return fmt.Errorf("%s: file_name은 필수입니다", p.Code)
+	}
+	if p.Options.EnglishRepeatCount < 0 || p.Options.SpeakSpeed < 0 || p.Options.PauseDuration < 0 || p.FontSize < 0 {
+		return fmt.Errorf("%s: options 값은 음수일 수 없습니다", p.Code)
+	}
+	return nil
+}
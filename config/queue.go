@@ -0,0 +1,51 @@
+package config
+
+import (
+	"log"
+	"time"
+)
+
+// 작업 대기열 기본값 (config.json의 Queue 항목이 비어있을 때 사용)
+const (
+	defaultQueuePollInterval = 5 * time.Second
+	defaultQueueMaxAttempts  = 3
+	defaultQueueRetryBackoff = time.Minute
+	maxQueueRetryBackoff     = 30 * time.Minute
+)
+
+// QueuePollInterval - worker가 대기 작업을 조회하는 주기
+func QueuePollInterval() time.Duration {
+	return parseQueueDuration("PollInterval", Config.Queue.PollInterval, defaultQueuePollInterval)
+}
+
+// QueueMaxAttempts - 작업당 최대 시도 횟수 (일시적 실패 재시도 포함)
+func QueueMaxAttempts() int {
+	if Config.Queue.MaxAttempts > 0 {
+		return Config.Queue.MaxAttempts
+	}
+	return defaultQueueMaxAttempts
+}
+
+// QueueRetryDelay - attempts번째 시도가 실패한 뒤 다음 시도까지 대기 시간 (지수 백오프, 최대 30분)
+func QueueRetryDelay(attempts int) time.Duration {
+	delay := parseQueueDuration("RetryBackoff", Config.Queue.RetryBackoff, defaultQueueRetryBackoff)
+	for i := 1; i < attempts && delay < maxQueueRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxQueueRetryBackoff {
+		delay = maxQueueRetryBackoff
+	}
+	return delay
+}
+
+func parseQueueDuration(name string, value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("Queue.%s 값이 올바르지 않아 기본값(%s)을 사용합니다: %q", name, fallback, value)
+		return fallback
+	}
+	return d
+}
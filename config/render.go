@@ -0,0 +1,19 @@
+package config
+
+import "runtime"
+
+// 항목별 렌더링 동시 실행 수 상한 (Render.Workers를 지정하지 않았을 때)
+// gTTS는 네트워크 요청이므로 CPU가 많아도 너무 많이 동시에 보내지 않도록 제한합니다.
+const maxDefaultRenderWorkers = 4
+
+// RenderWorkers - 항목(단어/문장)별 음성·클립 생성 동시 실행 수
+func RenderWorkers() int {
+	if Config.Render.Workers > 0 {
+		return Config.Render.Workers
+	}
+	workers := runtime.NumCPU()
+	if workers > maxDefaultRenderWorkers {
+		workers = maxDefaultRenderWorkers
+	}
+	return workers
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	ScheduleModeRun     = "run"     // scheduler 프로세스에서 바로 생성
+	ScheduleModeEnqueue = "enqueue" // DB 대기열에 등록하고 worker가 생성
+)
+
+// ScheduleConfig - scheduler가 주기적으로 실행하는 작업 (schedules 목록의 원소)
+type ScheduleConfig struct {
+	Name       string   `yaml:"name"` // 마지막 성공 시각을 기록하는 키 (고유해야 함)
+	Cron       string   `yaml:"cron"` // 분 시 일 월 요일 (예: "0 5 * * *")
+	Type       string   `yaml:"type"`
+	Types      []string `yaml:"types"`
+	DateOffset int      `yaml:"date_offset"` // 실행 시각 기준 대상 날짜 (1이면 내일 콘텐츠)
+	Mode       string   `yaml:"mode"`        // run(기본값) | enqueue
+	CatchUp    int      `yaml:"catch_up"`    // 꺼져 있던 동안 놓친 실행 중 최근 몇 번을 다시 실행할지 (기본값 1, 0 미만이면 다시 실행하지 않음)
+}
+
+// ServiceTypes - type과 types를 합친 서비스 타입 목록
+func (sc ScheduleConfig) ServiceTypes() []string {
+	types := sc.Types
+	if sc.Type != "" {
+		types = append([]string{sc.Type}, types...)
+	}
+	return types
+}
+
+// ModeOrDefault - mode가 비어있으면 run
+func (sc ScheduleConfig) ModeOrDefault() string {
+	if sc.Mode == "" {
+		return ScheduleModeRun
+	}
+	return sc.Mode
+}
+
+// CatchUpLimit - 놓친 실행을 최대 몇 번 다시 실행할지
+func (sc ScheduleConfig) CatchUpLimit() int {
+	switch {
+	case sc.CatchUp < 0:
+		return 0
+	case sc.CatchUp == 0:
+		return 1
+	}
+	return sc.CatchUp
+}
+
+// TargetDate - 실행 시각(runAt)에 date_offset을 더한 대상 날짜 (YYYYMMDD)
+func (sc ScheduleConfig) TargetDate(runAt time.Time) string {
+	return runAt.AddDate(0, 0, sc.DateOffset).Format("20060102")
+}
+
+// ValidateSchedules - 이름 중복, 타입 누락, mode 값을 검사합니다 (cron 표현식과 서비스 타입은 scheduler에서 검사)
+func ValidateSchedules(schedules []ScheduleConfig) error {
+	seen := make(map[string]bool)
+	for i, sc := range schedules {
+		if sc.Name == "" {
+			return fmt.Errorf("schedules[%d]: name을 지정해야 합니다", i)
+		}
+		if seen[sc.Name] {
+			return fmt.Errorf("schedules[%d]: name이 중복되었습니다: %s", i, sc.Name)
+		}
+		seen[sc.Name] = true
+
+		if len(sc.ServiceTypes()) == 0 {
+			return fmt.Errorf("schedules[%d] (%s): type 또는 types를 지정해야 합니다", i, sc.Name)
+		}
+		if mode := sc.ModeOrDefault(); mode != ScheduleModeRun && mode != ScheduleModeEnqueue {
+			return fmt.Errorf("schedules[%d] (%s): mode는 run 또는 enqueue여야 합니다 (입력값: %s)", i, sc.Name, sc.Mode)
+		}
+	}
+	return nil
+}
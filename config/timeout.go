@@ -0,0 +1,47 @@
+package config
+
+import (
+	"log"
+	"time"
+)
+
+// 제한 시간 기본값 (config.json의 Timeouts 항목이 비어있을 때 사용)
+const (
+	defaultJobTimeout    = 30 * time.Minute
+	defaultFFmpegTimeout = 5 * time.Minute
+	defaultSayTimeout    = time.Minute
+	defaultGTTSTimeout   = time.Minute
+)
+
+// JobTimeout - 작업(타입+날짜) 1건 전체의 제한 시간
+func JobTimeout() time.Duration {
+	return parseTimeout("Job", Config.Timeouts.Job, defaultJobTimeout)
+}
+
+// FFmpegTimeout - ffmpeg 호출 1회의 제한 시간
+func FFmpegTimeout() time.Duration {
+	return parseTimeout("FFmpeg", Config.Timeouts.FFmpeg, defaultFFmpegTimeout)
+}
+
+// SayTimeout - say(한국어 TTS) 호출 1회의 제한 시간
+func SayTimeout() time.Duration {
+	return parseTimeout("Say", Config.Timeouts.Say, defaultSayTimeout)
+}
+
+// GTTSTimeout - gTTS(영어 TTS) 호출 1회의 제한 시간
+func GTTSTimeout() time.Duration {
+	return parseTimeout("GTTS", Config.Timeouts.GTTS, defaultGTTSTimeout)
+}
+
+// parseTimeout - "90s", "5m" 형식의 값을 해석합니다. 비어있거나 잘못된 값이면 기본값을 사용합니다.
+func parseTimeout(name string, value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("Timeouts.%s 값이 올바르지 않아 기본값(%s)을 사용합니다: %q", name, fallback, value)
+		return fallback
+	}
+	return d
+}
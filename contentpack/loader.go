@@ -0,0 +1,248 @@
+package contentpack
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileDatePattern - 파일명에 포함된 기본 날짜 (예: 20261017-words.csv)
+var fileDatePattern = regexp.MustCompile(`(^|[^0-9])([0-9]{8})([^0-9]|$)`)
+
+// document - JSON/YAML 콘텐츠 팩 형식. date는 항목에 created_date가 없을 때의 기본 날짜입니다.
+type document struct {
+	Date          interface{}              `json:"date" yaml:"date"`
+	Words         []map[string]interface{} `json:"words" yaml:"words"`
+	Idioms        []map[string]interface{} `json:"idioms" yaml:"idioms"`
+	Sentences     []map[string]interface{} `json:"sentences" yaml:"sentences"`
+	LongformWords []map[string]interface{} `json:"longform_words" yaml:"longform_words"`
+	Titles        []map[string]interface{} `json:"titles" yaml:"titles"`
+}
+
+// IsPackFile - 콘텐츠 팩으로 읽을 수 있는 확장자인지 여부 (.csv, .json, .yaml, .yml)
+func IsPackFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv", ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// Load - 파일 또는 디렉토리(하위 포함, 이름순)의 콘텐츠 팩을 모두 읽어 합칩니다
+// 잘못된 행이 있으면 "파일:위치: 원인" 목록을 모두 모아 에러로 반환합니다.
+func Load(paths ...string) (*Pack, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("콘텐츠 팩을 읽을 수 없습니다: %w", err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		var found []string
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && IsPackFile(p) {
+				found = append(found, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("콘텐츠 팩 디렉토리를 읽을 수 없습니다: %w", err)
+		}
+		sort.Strings(found)
+		files = append(files, found...)
+	}
+
+	pack := &Pack{}
+	var errs []error
+	for _, file := range files {
+		filePack, err := LoadFile(file)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		pack.Merge(filePack)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return pack, nil
+}
+
+// LoadFile - 콘텐츠 팩 파일 하나를 읽습니다 (확장자로 형식 판별)
+func LoadFile(path string) (*Pack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("콘텐츠 팩을 읽을 수 없습니다: %w", err)
+	}
+	return Parse(path, data)
+}
+
+// Parse - 콘텐츠 팩 내용을 해석합니다. 형식과 기본 날짜는 path(파일명)의 확장자와 YYYYMMDD로 정합니다.
+func Parse(path string, data []byte) (*Pack, error) {
+	// 스프레드시트에서 내보낸 UTF-8 파일의 BOM 제거
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	defaultDate := ""
+	if match := fileDatePattern.FindStringSubmatch(filepath.Base(path)); match != nil {
+		defaultDate = match[2]
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return parseCSV(path, data, defaultDate)
+	case ".json":
+		var doc document
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%s: JSON 형식이 올바르지 않습니다: %w", path, err)
+		}
+		return parseDocument(path, doc, defaultDate)
+	case ".yaml", ".yml":
+		var doc document
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("%s: YAML 형식이 올바르지 않습니다: %w", path, err)
+		}
+		return parseDocument(path, doc, defaultDate)
+	default:
+		return nil, fmt.Errorf("%s: 지원하지 않는 파일 형식입니다 (csv, json, yaml)", path)
+	}
+}
+
+// parseCSV - 헤더(DB 컬럼명)가 있는 CSV 한 파일은 한 종류의 레코드만 담습니다. 종류는 헤더로 판별합니다.
+func parseCSV(path string, data []byte, defaultDate string) (*Pack, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%s: CSV 형식이 올바르지 않습니다: %w", path, err)
+	}
+	if len(rows) == 0 {
+		return &Pack{}, nil
+	}
+
+	header := make([]string, len(rows[0]))
+	for i, column := range rows[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+	kind, ok := detectKind(header)
+	if !ok {
+		return nil, fmt.Errorf("%s: 헤더로 콘텐츠 종류를 알 수 없습니다 (english_word, idiom, english_sentence_1, word, title 중 하나가 필요): %v", path, header)
+	}
+
+	pack := &Pack{}
+	var errs []error
+	for i, row := range rows[1:] {
+		r := make(record, len(header))
+		blank := true
+		for j, column := range header {
+			value := strings.TrimSpace(row[j])
+			if value != "" {
+				r[column] = value
+				blank = false
+			}
+		}
+		if blank {
+			continue
+		}
+		// 헤더가 1행이므로 데이터는 2행부터
+		if err := pack.add(kind, r, defaultDate); err != nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %w", path, i+2, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return pack, nil
+}
+
+func parseDocument(path string, doc document, defaultDate string) (*Pack, error) {
+	if doc.Date != nil {
+		date, err := scalarString(doc.Date)
+		if err != nil {
+			return nil, fmt.Errorf("%s: date: %w", path, err)
+		}
+		defaultDate = date
+	}
+
+	sections := []struct {
+		kind  Kind
+		items []map[string]interface{}
+	}{
+		{KindWords, doc.Words},
+		{KindIdioms, doc.Idioms},
+		{KindSentences, doc.Sentences},
+		{KindLongformWords, doc.LongformWords},
+		{KindTitles, doc.Titles},
+	}
+
+	pack := &Pack{}
+	var errs []error
+	for _, section := range sections {
+		for i, item := range section.items {
+			r, err := toRecord(item)
+			if err == nil {
+				err = pack.add(section.kind, r, defaultDate)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s:%s[%d]: %w", path, section.kind, i, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return pack, nil
+}
+
+func toRecord(item map[string]interface{}) (record, error) {
+	r := make(record, len(item))
+	for key, value := range item {
+		s, err := scalarString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if s != "" {
+			r[strings.ToLower(strings.TrimSpace(key))] = s
+		}
+	}
+	return r, nil
+}
+
+// scalarString - JSON/YAML 값을 문자열로 변환합니다 (YAML에서 따옴표 없이 쓴 20261017 같은 숫자 포함)
+func scalarString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(v), nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int64, uint64, float64:
+		return fmt.Sprint(v), nil
+	default:
+		return "", fmt.Errorf("문자열/숫자 값이어야 합니다: %v", v)
+	}
+}
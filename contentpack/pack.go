@@ -0,0 +1,179 @@
+package contentpack
+
+import (
+	"fmt"
+	"sort"
+
+	"auto-video-service/entity"
+)
+
+// Pack - 날짜별 콘텐츠 묶음 (파일에서 읽은 단어, 숙어, 문장, 롱폼 단어, 타이틀)
+type Pack struct {
+	Words         []entity.EnglishWord
+	Idioms        []entity.EnglishIdiom
+	Sentences     []entity.ShortSentence
+	LongformWords []entity.LongformWord
+	Titles        []entity.Title
+}
+
+// Counts - 종류별 레코드 수
+type Counts struct {
+	Words         int
+	Idioms        int
+	Sentences     int
+	LongformWords int
+	Titles        int
+}
+
+// Total - 전체 레코드 수
+func (c Counts) Total() int {
+	return c.Words + c.Idioms + c.Sentences + c.LongformWords + c.Titles
+}
+
+// Sub - 종류별로 other만큼 뺀 결과
+func (c Counts) Sub(other Counts) Counts {
+	return Counts{
+		Words:         c.Words - other.Words,
+		Idioms:        c.Idioms - other.Idioms,
+		Sentences:     c.Sentences - other.Sentences,
+		LongformWords: c.LongformWords - other.LongformWords,
+		Titles:        c.Titles - other.Titles,
+	}
+}
+
+func (c Counts) String() string {
+	return fmt.Sprintf("단어 %d, 숙어 %d, 문장 %d, 롱폼 단어 %d, 타이틀 %d", c.Words, c.Idioms, c.Sentences, c.LongformWords, c.Titles)
+}
+
+// Counts - 팩의 종류별 레코드 수
+func (p *Pack) Counts() Counts {
+	return Counts{
+		Words:         len(p.Words),
+		Idioms:        len(p.Idioms),
+		Sentences:     len(p.Sentences),
+		LongformWords: len(p.LongformWords),
+		Titles:        len(p.Titles),
+	}
+}
+
+// Merge - 다른 팩의 레코드를 뒤에 추가합니다
+func (p *Pack) Merge(other *Pack) {
+	p.Words = append(p.Words, other.Words...)
+	p.Idioms = append(p.Idioms, other.Idioms...)
+	p.Sentences = append(p.Sentences, other.Sentences...)
+	p.LongformWords = append(p.LongformWords, other.LongformWords...)
+	p.Titles = append(p.Titles, other.Titles...)
+}
+
+// Dates - 팩에 포함된 created_date 목록 (오름차순)
+func (p *Pack) Dates() []string {
+	seen := make(map[string]bool)
+	mark := func(date string) { seen[date] = true }
+	for _, w := range p.Words {
+		mark(w.CreatedDate)
+	}
+	for _, i := range p.Idioms {
+		mark(i.CreatedDate)
+	}
+	for _, s := range p.Sentences {
+		mark(s.CreatedDate)
+	}
+	for _, lw := range p.LongformWords {
+		mark(lw.CreatedDate)
+	}
+	for _, t := range p.Titles {
+		mark(t.CreatedDate)
+	}
+	dates := make([]string, 0, len(seen))
+	for date := range seen {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates
+}
+
+// Dedupe - 같은 날짜에 같은 내용(대소문자/공백 무시)이 두 번 이상 있으면 첫 번째만 남기고, 제거한 수를 반환합니다
+// 타이틀은 날짜당 하나만 남깁니다.
+func (p *Pack) Dedupe() Counts {
+	before := p.Counts()
+	*p = p.Without(&Pack{})
+	return before.Sub(p.Counts())
+}
+
+// Without - existing에 이미 있는 레코드와 팩 안의 중복을 제외한 새 팩을 반환합니다 (DB 중복 등록 방지)
+func (p *Pack) Without(existing *Pack) Pack {
+	var result Pack
+
+	seen := make(map[string]bool)
+	for _, w := range existing.Words {
+		seen[wordKey(w)] = true
+	}
+	for _, w := range p.Words {
+		if key := wordKey(w); !seen[key] {
+			seen[key] = true
+			result.Words = append(result.Words, w)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, i := range existing.Idioms {
+		seen[idiomKey(i)] = true
+	}
+	for _, i := range p.Idioms {
+		if key := idiomKey(i); !seen[key] {
+			seen[key] = true
+			result.Idioms = append(result.Idioms, i)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, s := range existing.Sentences {
+		seen[sentenceKey(s)] = true
+	}
+	for _, s := range p.Sentences {
+		if key := sentenceKey(s); !seen[key] {
+			seen[key] = true
+			result.Sentences = append(result.Sentences, s)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, lw := range existing.LongformWords {
+		seen[longformWordKey(lw)] = true
+	}
+	for _, lw := range p.LongformWords {
+		if key := longformWordKey(lw); !seen[key] {
+			seen[key] = true
+			result.LongformWords = append(result.LongformWords, lw)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, t := range existing.Titles {
+		seen[t.CreatedDate] = true
+	}
+	for _, t := range p.Titles {
+		if !seen[t.CreatedDate] {
+			seen[t.CreatedDate] = true
+			result.Titles = append(result.Titles, t)
+		}
+	}
+
+	return result
+}
+
+func wordKey(w entity.EnglishWord) string {
+	return normalizeKey(w.CreatedDate, w.EnglishWord)
+}
+
+func idiomKey(i entity.EnglishIdiom) string {
+	return normalizeKey(i.CreatedDate, i.Idiom)
+}
+
+func sentenceKey(s entity.ShortSentence) string {
+	return normalizeKey(s.CreatedDate, s.EnglishSentence1, s.EnglishSentence2.String)
+}
+
+func longformWordKey(lw entity.LongformWord) string {
+	return normalizeKey(lw.CreatedDate, lw.Word)
+}
@@ -0,0 +1,97 @@
+package contentpack
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"auto-video-service/entity"
+)
+
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadCSVAndYAML(t *testing.T) {
+	dir := t.TempDir()
+	// 스프레드시트 내보내기: BOM, 대소문자/공백이 섞인 헤더, 빈 행
+	writeFile(t, dir, "20261017-words.csv", "\xef\xbb\xbfEnglish_Word, meaning ,pronunciation_kr\napple,사과,애플\n,,\nbanana,바나나,바나나\n")
+	writeFile(t, dir, "day.yaml", `date: 20261018
+sentences:
+  - {korean_sentence_1: 안녕, english_sentence_1: Hello, english_sentence_2: there, pronunciation: 헬로}
+longform_words:
+  - {word: run, meaning: 달리다, shorts_date: 20261019, content_type: word, created_date: "20261001"}
+titles:
+  - {title: 오늘의 단어, sub_title: 부제, is_uploaded: true}
+`)
+
+	pack, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := pack.Counts(); got != (Counts{Words: 2, Sentences: 1, LongformWords: 1, Titles: 1}) {
+		t.Fatalf("counts = %+v", got)
+	}
+	if w := pack.Words[0]; w.EnglishWord != "apple" || w.CreatedDate != "20261017" || w.PronunciationKr != "애플" {
+		t.Errorf("word = %+v", w)
+	}
+	if s := pack.Sentences[0]; s.CreatedDate != "20261018" || !s.EnglishSentence2.Valid || s.KoreanSentence2.Valid {
+		t.Errorf("sentence = %+v", s)
+	}
+	if lw := pack.LongformWords[0]; lw.CreatedDate != "20261001" || lw.ShortsDate != "20261019" || lw.Source != "import" {
+		t.Errorf("longform word = %+v", lw)
+	}
+	if !pack.Titles[0].IsUploaded {
+		t.Errorf("title = %+v", pack.Titles[0])
+	}
+}
+
+func TestLoadReportsEveryInvalidRow(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "bad.json", `{"date": "20261017", "idioms": [
+		{"idiom": "break a leg"},
+		{"idiom": "piece of cake", "meaning": "식은 죽 먹기", "created_date": "2026-10-17"},
+		{"idiom": "hit the sack", "meaning": "자다", "colour": "red"}
+	]}`)
+
+	_, err := LoadFile(path)
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"idioms[0]", "meaning", "idioms[1]", "created_date", "idioms[2]", "colour"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestDedupeAndWithout(t *testing.T) {
+	pack := &Pack{
+		Words: []entity.EnglishWord{
+			{EnglishWord: "Apple", CreatedDate: "20261017"},
+			{EnglishWord: " apple ", CreatedDate: "20261017"},
+			{EnglishWord: "apple", CreatedDate: "20261018"},
+			{EnglishWord: "banana", CreatedDate: "20261017"},
+		},
+		Titles: []entity.Title{{Title: "a", CreatedDate: "20261017"}, {Title: "b", CreatedDate: "20261017"}},
+	}
+
+	if removed := pack.Dedupe(); removed != (Counts{Words: 1, Titles: 1}) {
+		t.Fatalf("removed = %+v", removed)
+	}
+
+	existing := &Pack{Words: []entity.EnglishWord{{EnglishWord: "BANANA", CreatedDate: "20261017"}}}
+	rest := pack.Without(existing)
+	if len(rest.Words) != 2 || rest.Words[0].EnglishWord != "Apple" || rest.Words[1].CreatedDate != "20261018" {
+		t.Fatalf("rest = %+v", rest.Words)
+	}
+	if got := pack.Dates(); strings.Join(got, ",") != "20261017,20261018" {
+		t.Fatalf("dates = %v", got)
+	}
+}
@@ -0,0 +1,199 @@
+package contentpack
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+)
+
+// Kind - 콘텐츠 팩 레코드 종류 (JSON/YAML의 최상위 키, DB 테이블과 1:1)
+type Kind string
+
+const (
+	KindWords         Kind = "words"          // english_words
+	KindIdioms        Kind = "idioms"         // english_idioms
+	KindSentences     Kind = "sentences"      // short_sentences
+	KindLongformWords Kind = "longform_words" // longform_words
+	KindTitles        Kind = "titles"         // title
+)
+
+// record - 파일 한 행(CSV) 또는 항목 하나(JSON/YAML). 키는 DB 컬럼명과 같습니다.
+type record map[string]string
+
+// kindColumns - 종류별 허용 컬럼 (필수 컬럼은 true). id는 DB에서 내보낸 파일을 그대로 쓸 수 있도록 허용만 하고 무시합니다.
+var kindColumns = map[Kind]map[string]bool{
+	KindWords: {
+		"id": false, "english_word": true, "meaning": true, "pronunciation_kr": false, "phonetic_symbol": false, "created_date": false,
+		"sort_order": false,
+	},
+	KindIdioms: {
+		"id": false, "idiom": true, "meaning": true, "pronunciation_kr": false, "phonetic_symbol": false, "created_date": false,
+		"sort_order": false,
+	},
+	KindSentences: {
+		"id": false, "korean_sentence_1": true, "korean_sentence_2": false, "english_sentence_1": true, "english_sentence_2": false,
+		"pronunciation": true, "created_date": false, "sort_order": false,
+	},
+	KindLongformWords: {
+		"id": false, "word": true, "meaning": true, "pronunciation_kr": false, "phonetic_symbol": false, "source": false,
+		"created_date": false, "shorts_date": false, "content_type": false, "sort_order": false,
+	},
+	KindTitles: {
+		"id": false, "title": true, "sub_title": true, "created_date": false, "is_uploaded": false,
+	},
+}
+
+// detectKind - CSV 헤더로 레코드 종류를 판별합니다 (종류마다 고유한 필수 컬럼 기준)
+func detectKind(header []string) (Kind, bool) {
+	has := make(map[string]bool, len(header))
+	for _, column := range header {
+		has[column] = true
+	}
+	switch {
+	case has["english_word"]:
+		return KindWords, true
+	case has["idiom"]:
+		return KindIdioms, true
+	case has["english_sentence_1"]:
+		return KindSentences, true
+	case has["word"]:
+		return KindLongformWords, true
+	case has["title"]:
+		return KindTitles, true
+	}
+	return "", false
+}
+
+// add - 레코드를 검증하여 팩에 추가합니다. defaultDate는 created_date가 비어있을 때 사용합니다.
+func (p *Pack) add(kind Kind, r record, defaultDate string) error {
+	columns, ok := kindColumns[kind]
+	if !ok {
+		return fmt.Errorf("알 수 없는 콘텐츠 종류입니다: %s", kind)
+	}
+	for column := range r {
+		if _, allowed := columns[column]; !allowed {
+			return fmt.Errorf("%s에 없는 컬럼입니다: %s", kind, column)
+		}
+	}
+	for column, required := range columns {
+		if required && r[column] == "" {
+			return fmt.Errorf("필수 컬럼 %s 값이 비어있습니다", column)
+		}
+	}
+
+	createdDate := r["created_date"]
+	if createdDate == "" {
+		createdDate = defaultDate
+	}
+	if createdDate == "" {
+		return fmt.Errorf("created_date가 없습니다 (행에 지정하거나 파일명/date 항목에 YYYYMMDD 날짜를 넣으세요)")
+	}
+	if err := validateDate("created_date", createdDate); err != nil {
+		return err
+	}
+	sortOrder := 0
+	if value := r["sort_order"]; value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("sort_order 값이 올바르지 않습니다 (정수): %q", value)
+		}
+		sortOrder = parsed
+	}
+
+	switch kind {
+	case KindWords:
+		p.Words = append(p.Words, entity.EnglishWord{
+			EnglishWord:     r["english_word"],
+			Meaning:         r["meaning"],
+			PronunciationKr: r["pronunciation_kr"],
+			PhoneticSymbol:  r["phonetic_symbol"],
+			CreatedDate:     createdDate,
+			SortOrder:       sortOrder,
+		})
+	case KindIdioms:
+		p.Idioms = append(p.Idioms, entity.EnglishIdiom{
+			Idiom:           r["idiom"],
+			Meaning:         r["meaning"],
+			PronunciationKr: r["pronunciation_kr"],
+			PhoneticSymbol:  r["phonetic_symbol"],
+			CreatedDate:     createdDate,
+			SortOrder:       sortOrder,
+		})
+	case KindSentences:
+		p.Sentences = append(p.Sentences, entity.ShortSentence{
+			KoreanSentence1:  r["korean_sentence_1"],
+			KoreanSentence2:  nullString(r["korean_sentence_2"]),
+			EnglishSentence1: r["english_sentence_1"],
+			EnglishSentence2: nullString(r["english_sentence_2"]),
+			Pronunciation:    r["pronunciation"],
+			CreatedDate:      createdDate,
+			SortOrder:        sortOrder,
+		})
+	case KindLongformWords:
+		word := entity.LongformWord{
+			Word:            r["word"],
+			Meaning:         r["meaning"],
+			PronunciationKr: r["pronunciation_kr"],
+			PhoneticSymbol:  r["phonetic_symbol"],
+			Source:          r["source"],
+			CreatedDate:     createdDate,
+			ShortsDate:      r["shorts_date"],
+			ContentType:     r["content_type"],
+			SortOrder:       sortOrder,
+		}
+		if word.Source == "" {
+			word.Source = "import"
+		}
+		if word.ShortsDate != "" {
+			if err := validateDate("shorts_date", word.ShortsDate); err != nil {
+				return err
+			}
+		}
+		switch enum.ContentType(word.ContentType) {
+		case "", enum.ContentWord, enum.ContentIdiom, enum.ContentSentence:
+		default:
+			return fmt.Errorf("content_type 값이 올바르지 않습니다 (word, idiom, sentence): %q", word.ContentType)
+		}
+		p.LongformWords = append(p.LongformWords, word)
+	case KindTitles:
+		title := entity.Title{
+			Title:       r["title"],
+			SubTitle:    r["sub_title"],
+			CreatedDate: createdDate,
+		}
+		if value := r["is_uploaded"]; value != "" {
+			uploaded, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("is_uploaded 값이 올바르지 않습니다 (true/false): %q", value)
+			}
+			title.IsUploaded = uploaded
+		}
+		p.Titles = append(p.Titles, title)
+	}
+	return nil
+}
+
+func validateDate(column string, value string) error {
+	if _, err := time.Parse("20060102", value); err != nil {
+		return fmt.Errorf("%s 형식이 올바르지 않습니다 (YYYYMMDD): %q", column, value)
+	}
+	return nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// normalizeKey - 중복 판별용 키 (대소문자, 앞뒤/연속 공백 무시)
+func normalizeKey(parts ...string) string {
+	normalized := make([]string, len(parts))
+	for i, part := range parts {
+		normalized[i] = strings.ToLower(strings.Join(strings.Fields(part), " "))
+	}
+	return strings.Join(normalized, "\x00")
+}
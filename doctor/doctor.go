@@ -0,0 +1,301 @@
+package doctor
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/factory"
+	"auto-video-service/service"
+	"context"
+	"errors"
+	"fmt"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/image/font/opentype"
+)
+
+// checkTimeout - 외부 명령(python3, ffmpeg -encoders) 하나의 최대 실행 시간
+const checkTimeout = 10 * time.Second
+
+// Status - 검사 결과
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn" // 일부 기능만 영향 (예: ffprobe 없음 → 보고서 재생 시간 누락)
+	StatusFail Status = "fail"
+)
+
+// Result - 검사 항목 하나의 결과
+type Result struct {
+	Name   string
+	Status Status
+	Detail string
+	Hint   string // 실패 시 해결 방법
+	cause  error  // 실패 원인 분류 (apperror), 종료 코드 결정용
+}
+
+// Options - 검사 범위
+type Options struct {
+	Types []string // 검사할 서비스 타입 (비어있으면 등록된 전체)
+	// Quick - 빠른 검사: 실행 파일/폰트/템플릿 존재 여부만 확인합니다.
+	// 전체 검사는 gTTS 모듈, ffmpeg 인코더, 폰트/PNG 파싱, 작업 디렉토리 쓰기, DB 연결까지 확인합니다.
+	Quick bool
+}
+
+// Run - 설정된 서비스 타입이 필요로 하는 도구와 파일을 검사합니다
+func Run(ctx context.Context, opts Options) []Result {
+	types := opts.Types
+	if len(types) == 0 {
+		types = factory.Codes()
+	}
+
+	needsTTS := false
+	fonts := make(map[string][]string) // 경로 → 사용하는 타입
+	files := make(map[string][]string)
+	for _, code := range types {
+		spec, ok := factory.Lookup(code)
+		if !ok || spec.Requires == nil {
+			continue
+		}
+		requirements := spec.Requires()
+		needsTTS = needsTTS || requirements.TTS
+		for _, path := range requirements.Fonts {
+			fonts[path] = appendUnique(fonts[path], code)
+		}
+		for _, path := range requirements.Files {
+			files[path] = appendUnique(files[path], code)
+		}
+	}
+
+	results := make([]Result, 0)
+	results = append(results, checkBinary("ffmpeg", "brew install ffmpeg (macOS) 또는 apt install ffmpeg", StatusFail))
+	results = append(results, checkBinary("ffprobe", "ffmpeg 패키지에 포함되어 있습니다. 없으면 실행 보고서/plan의 재생 시간이 비어 있습니다", StatusWarn))
+	if !opts.Quick {
+		results = append(results, checkEncoders(ctx)...)
+	}
+
+	if needsTTS {
+		results = append(results, checkSay())
+		results = append(results, checkBinary("python3", "Python 3를 설치하세요 (brew install python)", StatusFail))
+		if !opts.Quick {
+			results = append(results, checkGTTS(ctx))
+		}
+	}
+
+	for _, path := range sortedKeys(fonts) {
+		results = append(results, checkFont(path, fonts[path], opts.Quick))
+	}
+	for _, path := range sortedKeys(files) {
+		results = append(results, checkFile(path, files[path], opts.Quick))
+	}
+
+	if !opts.Quick {
+		results = append(results, checkWritableDir("작업 디렉토리 (Paths.TempDir)", service.WorkspaceBaseDir()))
+		results = append(results, checkDatabase(ctx))
+	}
+	return results
+}
+
+// Failed - 실패 항목이 있으면 첫 번째 실패를 에러로 반환합니다 (템플릿/폰트 누락이면 ErrMissingTemplate)
+func Failed(results []Result) error {
+	for _, result := range results {
+		if result.Status != StatusFail {
+			continue
+		}
+		if result.cause != nil {
+			return fmt.Errorf("%w: %s: %s", result.cause, result.Name, result.Detail)
+		}
+		return fmt.Errorf("%s: %s", result.Name, result.Detail)
+	}
+	return nil
+}
+
+func checkBinary(name string, hint string, failStatus Status) Result {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return Result{Name: "실행 파일 " + name, Status: failStatus, Detail: "PATH에서 찾을 수 없습니다", Hint: hint}
+	}
+	return Result{Name: "실행 파일 " + name, Status: StatusPass, Detail: path}
+}
+
+// checkSay - 한국어 음성(Yuna)은 macOS say 명령으로만 생성할 수 있습니다
+func checkSay() Result {
+	result := checkBinary("say", "macOS 전용 명령입니다. TTS가 필요한 타입은 macOS에서 실행하세요", StatusFail)
+	if result.Status == StatusFail && runtime.GOOS != "darwin" {
+		result.Detail = fmt.Sprintf("PATH에서 찾을 수 없습니다 (현재 OS: %s)", runtime.GOOS)
+	}
+	if result.Status == StatusFail {
+		result.cause = apperror.ErrTTS
+	}
+	return result
+}
+
+func checkGTTS(ctx context.Context) Result {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "python3", "-c", "import gtts; print(gtts.__version__)").CombinedOutput()
+	if err != nil {
+		return Result{Name: "python3 모듈 gtts", Status: StatusFail, Detail: lastLine(output, err), Hint: "pip3 install gTTS", cause: apperror.ErrTTS}
+	}
+	return Result{Name: "python3 모듈 gtts", Status: StatusPass, Detail: "버전 " + strings.TrimSpace(string(output))}
+}
+
+// requiredEncoders - 클립/음성 생성에 쓰는 ffmpeg 인코더
+var requiredEncoders = []struct {
+	name string
+	use  string
+}{
+	{"libx264", "영상 클립 (H.264)"},
+	{"aac", "영상 클립 오디오"},
+	{"libmp3lame", "음성 mp3 변환"},
+}
+
+func checkEncoders(ctx context.Context) []Result {
+	if _, err := exec.LookPath("ffmpeg"); err != nil {
+		return nil // 실행 파일 검사에서 이미 실패로 표시
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, "ffmpeg", "-hide_banner", "-encoders").CombinedOutput()
+	if err != nil {
+		return []Result{{Name: "ffmpeg 인코더", Status: StatusFail, Detail: lastLine(output, err), cause: apperror.ErrFFmpeg}}
+	}
+
+	available := make(map[string]bool)
+	for _, line := range strings.Split(string(output), "\n") {
+		// 형식: " V....D libx264              libx264 H.264 / AVC ..."
+		fields := strings.Fields(line)
+		if len(fields) >= 2 {
+			available[fields[1]] = true
+		}
+	}
+
+	results := make([]Result, 0, len(requiredEncoders))
+	for _, encoder := range requiredEncoders {
+		name := "ffmpeg 인코더 " + encoder.name
+		if available[encoder.name] {
+			results = append(results, Result{Name: name, Status: StatusPass, Detail: encoder.use})
+			continue
+		}
+		results = append(results, Result{
+			Name:   name,
+			Status: StatusFail,
+			Detail: encoder.use + "에 필요하지만 이 ffmpeg 빌드에 없습니다",
+			Hint:   "libx264/libmp3lame을 포함한 빌드를 설치하세요 (brew install ffmpeg, 또는 apt install ffmpeg libavcodec-extra)",
+			cause:  apperror.ErrFFmpeg,
+		})
+	}
+	return results
+}
+
+func checkFont(path string, usedBy []string, quick bool) Result {
+	name := fmt.Sprintf("폰트 %s (%s)", path, strings.Join(usedBy, ","))
+	if path == "" {
+		return Result{Name: "폰트 (" + strings.Join(usedBy, ",") + ")", Status: StatusFail, Detail: "config.json에 경로가 비어 있습니다", Hint: "config.json의 FontPath/BoldFontPath/TitleFontPath를 설정하세요", cause: apperror.ErrMissingTemplate}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Result{Name: name, Status: StatusFail, Detail: err.Error(), Hint: "폰트 파일 경로를 확인하세요 (config.json)", cause: apperror.ErrMissingTemplate}
+	}
+	if !quick {
+		if _, err := opentype.Parse(data); err != nil {
+			return Result{Name: name, Status: StatusFail, Detail: "폰트 파싱 실패: " + err.Error(), Hint: "TTF/OTF 폰트 파일인지 확인하세요", cause: apperror.ErrMissingTemplate}
+		}
+	}
+	return Result{Name: name, Status: StatusPass}
+}
+
+func checkFile(path string, usedBy []string, quick bool) Result {
+	name := fmt.Sprintf("파일 %s (%s)", path, strings.Join(usedBy, ","))
+	if path == "" {
+		return Result{Name: "파일 (" + strings.Join(usedBy, ",") + ")", Status: StatusFail, Detail: "config.json에 경로가 비어 있습니다", Hint: "config.json의 Paths.Templates / StartAudioPath를 설정하세요", cause: apperror.ErrMissingTemplate}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return Result{Name: name, Status: StatusFail, Detail: err.Error(), Hint: "템플릿 경로를 확인하세요 (config.json 또는 profiles.yaml의 template)", cause: apperror.ErrMissingTemplate}
+	}
+	defer file.Close()
+
+	if !quick && strings.EqualFold(filepath.Ext(path), ".png") {
+		if _, err := png.DecodeConfig(file); err != nil {
+			return Result{Name: name, Status: StatusFail, Detail: "PNG 해석 실패: " + err.Error(), Hint: "PNG 이미지인지 확인하세요", cause: apperror.ErrMissingTemplate}
+		}
+	}
+	return Result{Name: name, Status: StatusPass}
+}
+
+func checkWritableDir(name string, dir string) Result {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return Result{Name: name, Status: StatusFail, Detail: err.Error(), Hint: "디렉토리 권한을 확인하세요"}
+	}
+	file, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		return Result{Name: name, Status: StatusFail, Detail: err.Error(), Hint: "디렉토리 권한을 확인하세요"}
+	}
+	file.Close()
+	os.Remove(file.Name())
+	return Result{Name: name, Status: StatusPass, Detail: dir}
+}
+
+func checkDatabase(ctx context.Context) (result Result) {
+	result = Result{Name: "데이터베이스 연결", Hint: "config.json의 Database.Driver / ConnectionString과 DB 서버 상태를 확인하세요"}
+	defer func() {
+		// ConfigureDatabase는 드라이버 오류 시 panic
+		if r := recover(); r != nil {
+			result.Status = StatusFail
+			result.Detail = fmt.Sprint(r)
+		}
+	}()
+
+	db := config.ConfigureDatabase()
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	if err := db.DB().PingContext(ctx); err != nil {
+		result.Status = StatusFail
+		result.Detail = err.Error()
+		return result
+	}
+	result.Status = StatusPass
+	result.Detail = fmt.Sprintf("%s (%s)", config.Config.Database.Driver, config.Config.Database.Connection)
+	result.Hint = ""
+	return result
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// lastLine - 명령 출력의 마지막 줄 (출력이 없으면 에러 메시지)
+func lastLine(output []byte, err error) string {
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+		return last
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "시간 초과"
+	}
+	return err.Error()
+}
@@ -0,0 +1,36 @@
+package doctor
+
+import (
+	"auto-video-service/apperror"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckFileReportsMissingTemplate(t *testing.T) {
+	dir := t.TempDir()
+	missing := checkFile(filepath.Join(dir, "missing.png"), []string{"iw"}, true)
+	if missing.Status != StatusFail || missing.Hint == "" {
+		t.Fatalf("missing file result = %+v, want fail with hint", missing)
+	}
+
+	broken := filepath.Join(dir, "broken.png")
+	if err := os.WriteFile(broken, []byte("not a png"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if result := checkFile(broken, []string{"iw"}, true); result.Status != StatusPass {
+		t.Errorf("quick check should only test existence, got %+v", result)
+	}
+	if result := checkFile(broken, []string{"iw"}, false); result.Status != StatusFail {
+		t.Errorf("full check should decode PNG, got %+v", result)
+	}
+
+	err := Failed([]Result{{Name: "ok", Status: StatusPass}, missing})
+	if !errors.Is(err, apperror.ErrMissingTemplate) {
+		t.Errorf("Failed() = %v, want ErrMissingTemplate", err)
+	}
+	if Failed([]Result{{Name: "warn", Status: StatusWarn}}) != nil {
+		t.Errorf("warnings should not fail")
+	}
+}
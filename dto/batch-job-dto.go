@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+// BatchJob - 배치 실행 단위 (서비스 타입 + 날짜)
+type BatchJob struct {
+	ServiceType string
+	Date        string // YYYYMMDD
+}
+
+// BatchJobResult - 배치 작업 실행 결과
+type BatchJobResult struct {
+	Job      BatchJob
+	Response VideoCreationResponse
+	Success  bool
+	Error    error
+	Duration time.Duration
+	Stages   []StageTiming // 단계별 소요 시간 (진행 이벤트에서 수집)
+	Skipped  bool          // 이미 생성된 영상이 있어 건너뜀 (Success는 true, Response.FinalFileName은 기존 파일)
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"auto-video-service/enum"
+	"time"
+)
+
+// OptionOverrides - 작업 단위로 프로필 옵션을 덮어쓰는 값 (nil이면 프로필 값 사용)
+type OptionOverrides struct {
+	EnglishRepeatCount *int     `json:"english_repeat_count,omitempty"`
+	SpeakSpeed         *float64 `json:"speak_speed,omitempty"`
+	PauseDuration      *float64 `json:"pause_duration,omitempty"`
+}
+
+// GenerationJobRequest - 영상 생성 작업 등록 요청 (POST /jobs)
+type GenerationJobRequest struct {
+	Type    string           `json:"type"`
+	Date    string           `json:"date"` // YYYYMMDD 또는 today
+	Options *OptionOverrides `json:"options,omitempty"`
+	Force   bool             `json:"force,omitempty"` // 이미 생성된 영상이 있어도 다시 생성
+}
+
+// GenerationJob - 영상 생성 작업 상태
+type GenerationJob struct {
+	ID         int64            `json:"id"`
+	Type       string           `json:"type"`
+	Date       string           `json:"date"`
+	Options    *OptionOverrides `json:"options,omitempty"`
+	Force      bool             `json:"force,omitempty"`
+	Status     enum.JobStatus   `json:"status"`
+	Skipped    bool             `json:"skipped,omitempty"` // 이미 생성된 영상이 있어 건너뜀 (Status는 done, Output은 기존 파일)
+	Output     string           `json:"output,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	StartedAt  *time.Time       `json:"started_at,omitempty"`
+	FinishedAt *time.Time       `json:"finished_at,omitempty"`
+}
+
+// OutputFile - 생성 완료된 영상 파일 정보 (GET /outputs)
+type OutputFile struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modified_at"`
+	URL        string    `json:"url"`
+}
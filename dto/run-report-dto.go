@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// StageTiming - 작업 단계별 소요 시간
+type StageTiming struct {
+	Name        string  `json:"name"`
+	DurationSec float64 `json:"duration_sec"`
+}
+
+// RunReport - generate 실행 결과 보고서 (--report로 JSON 저장)
+type RunReport struct {
+	StartedAt   time.Time   `json:"started_at"`
+	FinishedAt  time.Time   `json:"finished_at"`
+	DurationSec float64     `json:"duration_sec"`
+	Succeeded   int         `json:"succeeded"`
+	Skipped     int         `json:"skipped"`
+	Failed      int         `json:"failed"`
+	Jobs        []JobReport `json:"jobs"`
+}
+
+// JobReport - 작업 하나의 결과
+type JobReport struct {
+	Type        string         `json:"type"`
+	Date        string         `json:"date"`
+	Success     bool           `json:"success"`
+	Skipped     bool           `json:"skipped,omitempty"` // 이미 생성된 영상이 있어 건너뜀
+	Error       string         `json:"error,omitempty"`
+	DurationSec float64        `json:"duration_sec"`
+	Files       []ProducedFile `json:"files"`
+	Stages      []StageTiming  `json:"stages"`
+}
+
+// ProducedFile - 생성된 영상 파일
+type ProducedFile struct {
+	Path        string  `json:"path"`
+	Size        int64   `json:"size"`
+	DurationSec float64 `json:"duration_sec"` // 영상 재생 시간 (ffprobe 실패 시 0)
+}
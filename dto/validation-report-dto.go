@@ -0,0 +1,46 @@
+package dto
+
+// 콘텐츠 검증 문제 수준
+const (
+	ValidationError   = "error"   // 렌더링하면 글자가 넘치거나 빈 칸이 보임 (기본적으로 렌더링 차단)
+	ValidationWarning = "warning" // 렌더링은 되지만 확인이 필요함
+)
+
+// ValidationReport - 렌더링 전 콘텐츠 검증 결과 (행별 문제 목록)
+type ValidationReport struct {
+	ServiceType string            `json:"service_type"`
+	TargetDate  string            `json:"target_date"`
+	Template    string            `json:"template"`
+	Rows        int               `json:"rows"`
+	Issues      []ValidationIssue `json:"issues"`
+}
+
+// ValidationIssue - 한 행, 한 컬럼의 문제와 고치는 방법
+type ValidationIssue struct {
+	Row      int    `json:"row"` // 1부터 (영상 안 순서)
+	Id       int64  `json:"id"`  // DB id (콘텐츠 팩 파일은 0)
+	Field    string `json:"field"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Errors - error 수준 문제 수
+func (r ValidationReport) Errors() int {
+	return r.count(ValidationError)
+}
+
+// Warnings - warning 수준 문제 수
+func (r ValidationReport) Warnings() int {
+	return r.count(ValidationWarning)
+}
+
+func (r ValidationReport) count(severity string) int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
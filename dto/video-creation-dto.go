@@ -7,9 +7,11 @@ import (
 
 // VideoCreationRequest - 비디오 생성 요청 DTO
 type VideoCreationRequest struct {
-	TargetDate  time.Time
-	ServiceType string
-	ContentType enum.ContentType
+	TargetDate     time.Time
+	ServiceType    string
+	ContentType    enum.ContentType
+	OutputFileName string          // 최종 영상 경로 (레지스트리의 파일명 규칙으로 생성)
+	Overrides      OptionOverrides // 작업 단위 옵션 덮어쓰기 (API 요청 등)
 }
 
 // ContentData - 컨텐츠 데이터 DTO (영어 단어/숙어 공통)
@@ -35,6 +37,18 @@ type VideoCreationResponse struct {
 	ContentCount  int
 	Success       bool
 	Error         error
+	ItemIds       []int64             // 사용한 콘텐츠 id (생성 이력에 기록, 파일 콘텐츠는 0)
+	Settings      *GenerationSettings // 실제 적용된 생성 설정 (생성 이력에 기록)
+}
+
+// GenerationSettings - 생성 이력(generation_runs.options)에 기록하는 실제 적용 설정
+type GenerationSettings struct {
+	Template           string  `json:"template,omitempty"`
+	EnglishRepeatCount int     `json:"english_repeat_count,omitempty"`
+	SpeakSpeed         float64 `json:"speak_speed,omitempty"`
+	PauseDuration      float64 `json:"pause_duration,omitempty"`
+	IsReverse          bool    `json:"is_reverse,omitempty"`
+	FontSize           float64 `json:"font_size,omitempty"`
 }
 
 // VideoCreationOptions - 비디오 생성 옵션 DTO (플랫폼, 길이, 반복 등)
@@ -55,4 +69,6 @@ type ContentDataResult struct {
 	Secondary      []string // 한국어
 	SecondaryLine2 []string // 한국어 2번째 줄 (문장 전용)
 	Tertiary       []string // 발음
+	Phonetic       []string // IPA 발음기호 (phonetic_symbol, 문장은 없음)
+	Ids            []int64  // 항목별 DB id (파일 콘텐츠는 0)
 }
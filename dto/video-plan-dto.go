@@ -0,0 +1,39 @@
+package dto
+
+// VideoPlan - plan 모드 결과: 렌더링 없이 계산한 영상 구성
+type VideoPlan struct {
+	ServiceType   string        `json:"service_type"`
+	TargetDate    string        `json:"target_date"`
+	FinalFileName string        `json:"final_file_name"`
+	Contents      []PlanContent `json:"contents"`
+	Slides        []PlanSlide   `json:"slides"`
+	Clips         []PlanClip    `json:"clips"`
+	EstimatedSec  float64       `json:"estimated_sec"` // 클립 예상 길이의 합
+}
+
+// PlanContent - 조회된 콘텐츠 한 행
+type PlanContent struct {
+	Index          int    `json:"index"`
+	Primary        string `json:"primary"`
+	PrimaryLine2   string `json:"primary_line2,omitempty"`
+	Secondary      string `json:"secondary"`
+	SecondaryLine2 string `json:"secondary_line2,omitempty"`
+	Tertiary       string `json:"tertiary,omitempty"`
+}
+
+// PlanSlide - 슬라이드 이미지 한 장과 그 위에 그려질 텍스트
+type PlanSlide struct {
+	Index int      `json:"index"` // 이미지 번호 (output_01.png → 1)
+	File  string   `json:"file"`
+	Lines []string `json:"lines"`
+}
+
+// PlanClip - 최종 영상에 이어 붙일 클립 하나 (videoPaths 순서)
+type PlanClip struct {
+	Order        int     `json:"order"`
+	File         string  `json:"file"`
+	Kind         string  `json:"kind"`            // korean | english | silence | title | start_comment
+	Slide        string  `json:"slide,omitempty"` // 사용할 슬라이드 이미지
+	Speech       string  `json:"speech,omitempty"`
+	EstimatedSec float64 `json:"estimated_sec"`
+}
@@ -0,0 +1,153 @@
+package duplicate
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"auto-video-service/contentpack"
+	"auto-video-service/entity"
+)
+
+// Entry - 중복 검사 대상 한 행
+type Entry struct {
+	Kind contentpack.Kind // 같은 종류끼리만 비교
+	Id   int64
+	Date string // YYYYMMDD (created_date, 유튜브 숏폼으로 배정된 롱폼 단어는 shorts_date)
+	Text string // 원문 (단어, 숙어, 문장 1 + 문장 2)
+}
+
+// Group - 기간 안에 서로 다른 날짜로 반복된 같은 콘텐츠
+type Group struct {
+	Kind    contentpack.Kind
+	Key     string // Normalize 결과
+	Entries []Entry
+}
+
+// Dates - 그룹에 포함된 날짜 목록 (오름차순)
+func (g Group) Dates() []string {
+	dates := make([]string, 0, len(g.Entries))
+	for _, e := range g.Entries {
+		if len(dates) == 0 || dates[len(dates)-1] != e.Date {
+			dates = append(dates, e.Date)
+		}
+	}
+	return dates
+}
+
+// Has - 그룹에 date의 행이 있는지
+func (g Group) Has(date string) bool {
+	for _, e := range g.Entries {
+		if e.Date == date {
+			return true
+		}
+	}
+	return false
+}
+
+// Near - date와 다른 날짜이면서 windowDays 이내인 행
+func (g Group) Near(date string, windowDays int) []Entry {
+	near := make([]Entry, 0)
+	for _, e := range g.Entries {
+		if e.Date != date && daysBetween(date, e.Date) <= windowDays {
+			near = append(near, e)
+		}
+	}
+	return near
+}
+
+// LongformDate - 롱폼 단어를 비교할 날짜. 유튜브 숏폼으로 배정되었으면 시청자가 보는 shorts_date, 아니면 created_date
+func LongformDate(lw entity.LongformWord) string {
+	if lw.ShortsDate != "" {
+		return lw.ShortsDate
+	}
+	return lw.CreatedDate
+}
+
+// FromPack - 영어단어, 숙어, 문장, 롱폼 단어를 검사 대상으로 변환합니다 (타이틀 제외)
+func FromPack(pack *contentpack.Pack) []Entry {
+	entries := make([]Entry, 0, pack.Counts().Total())
+	for _, w := range pack.Words {
+		entries = append(entries, Entry{Kind: contentpack.KindWords, Id: w.Id, Date: w.CreatedDate, Text: w.EnglishWord})
+	}
+	for _, i := range pack.Idioms {
+		entries = append(entries, Entry{Kind: contentpack.KindIdioms, Id: i.Id, Date: i.CreatedDate, Text: i.Idiom})
+	}
+	for _, s := range pack.Sentences {
+		text := s.EnglishSentence1
+		if s.EnglishSentence2.Valid {
+			text = strings.TrimSpace(text + " " + s.EnglishSentence2.String)
+		}
+		entries = append(entries, Entry{Kind: contentpack.KindSentences, Id: s.Id, Date: s.CreatedDate, Text: text})
+	}
+	for _, lw := range pack.LongformWords {
+		entries = append(entries, Entry{Kind: contentpack.KindLongformWords, Id: lw.Id, Date: LongformDate(lw), Text: lw.Word})
+	}
+	return entries
+}
+
+// Find - 같은 종류 안에서 정규화한 키가 같고 날짜 차이가 windowDays 이하인 행을 묶습니다
+// 같은 날짜끼리의 중복은 가져오기(import)에서 제외하므로 날짜가 다른 경우만 보고합니다.
+func Find(entries []Entry, windowDays int) []Group {
+	byKey := make(map[string][]Entry)
+	order := make([]string, 0)
+	for _, e := range entries {
+		normalized := Normalize(e.Text)
+		if normalized == "" {
+			continue
+		}
+		key := string(e.Kind) + "\x00" + normalized
+		if _, ok := byKey[key]; !ok {
+			order = append(order, key)
+		}
+		byKey[key] = append(byKey[key], e)
+	}
+
+	groups := make([]Group, 0)
+	for _, key := range order {
+		rows := byKey[key]
+		if len(rows) < 2 {
+			continue
+		}
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].Date < rows[j].Date })
+
+		// 날짜순으로 이웃한 행과 windowDays 이내이면 같은 그룹 (간격이 벌어지면 새 그룹)
+		current := []Entry{rows[0]}
+		flush := func() {
+			if len(Group{Entries: current}.Dates()) > 1 {
+				kind, normalized, _ := strings.Cut(key, "\x00")
+				groups = append(groups, Group{Kind: contentpack.Kind(kind), Key: normalized, Entries: current})
+			}
+		}
+		for _, row := range rows[1:] {
+			if daysBetween(current[len(current)-1].Date, row.Date) > windowDays {
+				flush()
+				current = nil
+			}
+			current = append(current, row)
+		}
+		flush()
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Entries[0].Date != groups[j].Entries[0].Date {
+			return groups[i].Entries[0].Date < groups[j].Entries[0].Date
+		}
+		return groups[i].Kind < groups[j].Kind
+	})
+	return groups
+}
+
+// daysBetween - 두 YYYYMMDD 날짜의 차이(일). 형식이 잘못되면 0
+func daysBetween(from, to string) int {
+	a, errA := time.Parse("20060102", from)
+	b, errB := time.Parse("20060102", to)
+	if errA != nil || errB != nil {
+		return 0
+	}
+	days := int(b.Sub(a).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
@@ -0,0 +1,73 @@
+package duplicate
+
+import (
+	"testing"
+
+	"auto-video-service/contentpack"
+	"auto-video-service/entity"
+)
+
+func TestNormalize(t *testing.T) {
+	same := [][]string{
+		{"Take sb for granted", "take something for granted.", "take sb/sth for granted", "take  SOMEONE  for granted!"},
+		{"make up one's mind", "Make up sb's mind", "make up someone’s mind"},
+		{"well-known", "Well known"},
+		{"I'm on my way.", "i'm on my way"},
+	}
+	for _, group := range same {
+		want := Normalize(group[0])
+		for _, text := range group[1:] {
+			if got := Normalize(text); got != want {
+				t.Errorf("Normalize(%q) = %q, want %q (same as %q)", text, got, want, group[0])
+			}
+		}
+	}
+	if Normalize("give up") == Normalize("give in") {
+		t.Errorf("different idioms normalized to the same key")
+	}
+}
+
+func TestFind(t *testing.T) {
+	entries := []Entry{
+		{Kind: contentpack.KindIdioms, Id: 1, Date: "20261001", Text: "take sb for granted"},
+		{Kind: contentpack.KindIdioms, Id: 2, Date: "20261020", Text: "Take something for granted."},
+		{Kind: contentpack.KindIdioms, Id: 3, Date: "20261201", Text: "take sth for granted"}, // window 밖
+		{Kind: contentpack.KindWords, Id: 4, Date: "20261005", Text: "take sb for granted"},   // 다른 종류
+		{Kind: contentpack.KindWords, Id: 5, Date: "20261005", Text: "apple"},
+		{Kind: contentpack.KindWords, Id: 6, Date: "20261005", Text: "Apple"}, // 같은 날짜끼리는 제외
+	}
+
+	groups := Find(entries, 30)
+	if len(groups) != 1 {
+		t.Fatalf("groups = %+v, want 1", groups)
+	}
+	g := groups[0]
+	if g.Kind != contentpack.KindIdioms || len(g.Entries) != 2 || g.Entries[0].Id != 1 || g.Entries[1].Id != 2 {
+		t.Errorf("group = %+v", g)
+	}
+	if near := g.Near("20261020", 30); len(near) != 1 || near[0].Id != 1 {
+		t.Errorf("Near = %+v", near)
+	}
+}
+
+func TestFromPackKeysLongformWordsOnShortsDate(t *testing.T) {
+	pack := &contentpack.Pack{LongformWords: []entity.LongformWord{
+		{Id: 1, Word: "run", CreatedDate: "20261001", ShortsDate: "20261010"},
+		{Id: 2, Word: "run", CreatedDate: "20261001", ShortsDate: "20261024"},
+		{Id: 3, Word: "walk", CreatedDate: "20261002"},
+	}}
+
+	entries := FromPack(pack)
+	want := map[int64]string{1: "20261010", 2: "20261024", 3: "20261002"}
+	for _, e := range entries {
+		if e.Date != want[e.Id] {
+			t.Errorf("entry %d date = %s, want %s", e.Id, e.Date, want[e.Id])
+		}
+	}
+
+	// 같은 날 만든 단어라도 한 달 안에 두 번 숏폼으로 배정되면 중복
+	groups := Find(entries, 30)
+	if len(groups) != 1 || len(groups[0].Entries) != 2 || !groups[0].Has("20261024") {
+		t.Fatalf("groups = %+v, want the two shorts dates of run", groups)
+	}
+}
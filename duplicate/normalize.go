@@ -0,0 +1,57 @@
+package duplicate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// placeholder - sb/sth 같은 목적어 자리 표시를 하나로 묶은 토큰
+const placeholder = "~"
+
+// placeholderWords - 같은 자리 표시로 보는 표기 (take sb for granted = take something for granted)
+var placeholderWords = map[string]string{
+	"sb":          placeholder,
+	"sth":         placeholder,
+	"smb":         placeholder,
+	"smth":        placeholder,
+	"somebody":    placeholder,
+	"someone":     placeholder,
+	"something":   placeholder,
+	"sb's":        placeholder + "'s",
+	"sth's":       placeholder + "'s",
+	"somebody's":  placeholder + "'s",
+	"someone's":   placeholder + "'s",
+	"something's": placeholder + "'s",
+	"one's":       placeholder + "'s",
+}
+
+// Normalize - 중복 비교용 키를 만듭니다
+// 대소문자, 문장부호, 공백 차이를 무시하고 sb/sth/somebody/something(과 sb/sth 같은 병기)을 하나의 자리 표시로 바꿉니다.
+func Normalize(text string) string {
+	text = strings.ToLower(text)
+	text = strings.NewReplacer("’", "'", "‘", "'", "/", " ", "-", " ").Replace(text)
+
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) || r == '\'' {
+			return r
+		}
+		return -1
+	}, text)
+
+	tokens := make([]string, 0)
+	for _, token := range strings.Fields(cleaned) {
+		token = strings.Trim(token, "'")
+		if token == "" {
+			continue
+		}
+		if mapped, ok := placeholderWords[token]; ok {
+			token = mapped
+		}
+		// sb/sth → "~ ~" 같은 연속 자리 표시는 하나로
+		if n := len(tokens); n > 0 && tokens[n-1] == token && strings.HasPrefix(token, placeholder) {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return strings.Join(tokens, " ")
+}
@@ -7,6 +7,7 @@ type EnglishWord struct {
 	PronunciationKr   string `xorm:"pronunciation_kr"`
 	PhoneticSymbol    string `xorm:"phonetic_symbol"`
 	CreatedDate       string `xorm:"created_date"`
+	SortOrder         int    `xorm:"sort_order notnull default 0"` // 같은 날짜 안의 순서 (작은 값부터, 같으면 id 순)
 }
 
 func (EnglishWord) TableName() string {
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// GenerationJob - 영상 생성 작업 대기열 (worker 데몬이 하나씩 가져가 실행)
+type GenerationJob struct {
+	Id          int64     `xorm:"id pk autoincr"`
+	ServiceType string    `xorm:"service_type varchar(20) notnull"`
+	TargetDate  string    `xorm:"target_date varchar(8) notnull"`
+	Options     string    `xorm:"options text"` // dto.OptionOverrides JSON (비어있으면 프로필 값 사용)
+	Status      string    `xorm:"status varchar(20) notnull index"`
+	Attempts    int       `xorm:"attempts notnull default 0"`
+	MaxAttempts int       `xorm:"max_attempts notnull default 3"`
+	LastError   string    `xorm:"last_error text"`
+	OutputFile  string    `xorm:"output_file varchar(255)"`
+	AvailableAt time.Time `xorm:"available_at notnull index"` // 재시도 대기 중이면 이 시각 이후에 실행
+	LockedBy    string    `xorm:"locked_by varchar(100)"`
+	LockedAt    time.Time `xorm:"locked_at"`
+	FinishedAt  time.Time `xorm:"finished_at"`
+	CreatedAt   time.Time `xorm:"created_at created"`
+	UpdatedAt   time.Time `xorm:"updated_at updated"`
+}
+
+func (GenerationJob) TableName() string {
+	return "generation_jobs"
+}
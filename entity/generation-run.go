@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// GenerationRun - 영상 생성 이력 (서비스 타입 + 날짜별로 언제, 어떤 설정으로 만들어 어디에 저장했는지)
+type GenerationRun struct {
+	Id          int64     `xorm:"id pk autoincr"`
+	ServiceType string    `xorm:"service_type varchar(20) notnull index(idx_generation_runs_type_date)"`
+	TargetDate  string    `xorm:"target_date varchar(8) notnull index(idx_generation_runs_type_date)"`
+	Status      string    `xorm:"status varchar(20) notnull"`
+	OutputFile  string    `xorm:"output_file varchar(255)"`
+	Checksum    string    `xorm:"checksum varchar(64)"` // 최종 파일 SHA-256 (hex)
+	DurationSec float64   `xorm:"duration_sec"`         // 영상 재생 시간 (ffprobe 실패 시 0)
+	ElapsedSec  float64   `xorm:"elapsed_sec"`          // 생성 소요 시간
+	ItemIds     string    `xorm:"item_ids text"`        // 사용한 콘텐츠 id JSON 배열 (파일 콘텐츠는 0)
+	Options     string    `xorm:"options text"`         // 실제 적용된 생성 설정 JSON (dto.GenerationSettings)
+	Error       string    `xorm:"error text"`
+	CreatedAt   time.Time `xorm:"created_at created"`
+}
+
+func (GenerationRun) TableName() string {
+	return "generation_runs"
+}
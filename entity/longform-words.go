@@ -10,6 +10,7 @@ type LongformWord struct {
 	CreatedDate     string `xorm:"created_date notnull"`
 	ShortsDate      string `xorm:"shorts_date"`
 	ContentType     string `xorm:"content_type"`
+	SortOrder       int    `xorm:"sort_order notnull default 0"` // 같은 날짜 안의 순서 (작은 값부터, 같으면 id 순)
 }
 
 func (LongformWord) TableName() string {
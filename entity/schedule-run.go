@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// ScheduleRun - scheduler 항목별 마지막 성공 실행 기록 (재시작 시 놓친 실행을 찾는 기준)
+type ScheduleRun struct {
+	Name           string    `xorm:"name varchar(100) pk"`
+	LastRunAt      time.Time `xorm:"last_run_at notnull"` // 성공한 실행의 예정 시각 (실제 완료 시각이 아님)
+	LastTargetDate string    `xorm:"last_target_date varchar(8)"`
+	UpdatedAt      time.Time `xorm:"updated_at updated"`
+}
+
+func (ScheduleRun) TableName() string {
+	return "schedule_runs"
+}
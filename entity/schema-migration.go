@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// SchemaMigration - 적용된 스키마 마이그레이션 버전 기록
+type SchemaMigration struct {
+	Version   int       `xorm:"'version' pk"` // version은 xorm 태그 예약어(낙관적 잠금)라 따옴표로 컬럼명 지정
+	Name      string    `xorm:"name varchar(200) notnull"`
+	AppliedAt time.Time `xorm:"applied_at notnull"`
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
@@ -11,6 +11,7 @@ type ShortSentence struct {
 	EnglishSentence2 sql.NullString `xorm:"english_sentence_2"`
 	Pronunciation    string         `xorm:"pronunciation notnull"`
 	CreatedDate      string         `xorm:"created_date notnull"`
+	SortOrder        int            `xorm:"sort_order notnull default 0"` // 같은 날짜 안의 순서 (작은 값부터, 같으면 id 순)
 }
 
 func (ShortSentence) TableName() string {
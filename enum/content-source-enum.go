@@ -0,0 +1,9 @@
+package enum
+
+// ContentSource 숏폼 콘텐츠 조회 방식
+type ContentSource string
+
+const (
+	ContentSourceShorts        ContentSource = "shorts"         // shorts DB (english_words/english_idioms/short_sentences)
+	ContentSourceYoutubeShorts ContentSource = "youtube_shorts" // longform_words의 shorts_date + content_type
+)
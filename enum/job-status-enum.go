@@ -0,0 +1,11 @@
+package enum
+
+// JobStatus 생성 작업 상태
+type JobStatus string
+
+const (
+	JobQueued  JobStatus = "queued"
+	JobRunning JobStatus = "running"
+	JobDone    JobStatus = "done"
+	JobFailed  JobStatus = "failed"
+)
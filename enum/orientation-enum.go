@@ -0,0 +1,9 @@
+package enum
+
+// Orientation 영상 방향
+type Orientation string
+
+const (
+	OrientationVertical   Orientation = "vertical"   // 세로형 1080x1920
+	OrientationHorizontal Orientation = "horizontal" // 가로형 1920x1080
+)
@@ -0,0 +1,10 @@
+package enum
+
+// PronunciationStyle 영어 슬라이드의 발음 줄 표기 방식
+type PronunciationStyle string
+
+const (
+	PronunciationKorean PronunciationStyle = "kr"   // 한글 발음 (pronunciation_kr, 기본값)
+	PronunciationIPA    PronunciationStyle = "ipa"  // IPA 발음기호 (phonetic_symbol)
+	PronunciationBoth   PronunciationStyle = "both" // 한글 발음 + IPA 발음기호
+)
@@ -0,0 +1,9 @@
+package enum
+
+// RunStatus 영상 생성 이력(generation_runs) 결과
+type RunStatus string
+
+const (
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+)
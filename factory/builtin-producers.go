@@ -0,0 +1,65 @@
+package factory
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/enum"
+	"auto-video-service/service"
+	"fmt"
+	"os"
+)
+
+// 기본 제공 서비스 타입 등록
+// 숏폼(릴스/쇼츠) 타입은 profiles.yaml에서 RegisterProfiles로 등록합니다.
+func init() {
+	// 유튜브 롱폼 (longform_words 사용, date 조회)
+	Register(ProducerSpec{Code: enum.YoutubeLongform, Description: "유튜브 롱폼 단어 영상", Platform: enum.PlatformYoutube, Orientation: enum.OrientationHorizontal, ContentType: enum.ContentWord, FileName: "{date}_longform", New: func() VideoProducer { return service.NewLongformWordService() }, Requires: longformRequirements})
+
+	// 기타 - 롱폼 앞에 붙는 시작 영상 (템플릿 경로에 저장)
+	Register(ProducerSpec{Code: enum.Start, Description: "롱폼 시작 코멘트 영상", Platform: enum.PlatformYoutube, Orientation: enum.OrientationHorizontal, New: func() VideoProducer { return service.NewStartService() }, Requires: startRequirements})
+}
+
+// longformRequirements - 롱폼: 타이틀/본문 폰트(보조 폰트 포함)와 템플릿, 스타트 코멘트 영상이 없으면 자동 생성에 필요한 파일
+func longformRequirements() Requirements {
+	templates := config.Config.Paths.Templates
+	requirements := Requirements{
+		TTS:   true,
+		Fonts: append(config.FontChain(config.FontRoleTitle), config.FontChain(config.FontRoleBold)...),
+		Files: []string{templates.Title, templates.BackgroundImg},
+	}
+	if _, err := os.Stat(templates.StartComment); err != nil {
+		requirements.Files = append(requirements.Files, startRequirements().Files...)
+	}
+	return requirements
+}
+
+// startRequirements - 스타트 코멘트: 이미지 2장과 녹음된 음성 (TTS 사용 안 함)
+func startRequirements() Requirements {
+	templates := config.Config.Paths.Templates
+	return Requirements{Files: []string{templates.StartImg, templates.GoodImg, config.Config.StartAudioPath}}
+}
+
+// RegisterProfiles - 플랫폼 프로필을 세로형 숏폼 서비스 타입으로 등록합니다
+// 프로필 파일은 사용자가 수정하는 데이터이므로 코드 중복은 panic 대신 에러로 반환합니다.
+func RegisterProfiles(profiles []config.PlatformProfile) error {
+	for _, profile := range profiles {
+		if _, exists := Lookup(profile.Code); exists {
+			return fmt.Errorf("프로필 code가 이미 등록된 서비스 타입과 겹칩니다: %s", profile.Code)
+		}
+	}
+	for _, profile := range profiles {
+		profile := profile
+		Register(ProducerSpec{
+			Code:        enum.ServiceType(profile.Code),
+			Description: profile.Description,
+			Platform:    profile.Platform,
+			Orientation: enum.OrientationVertical,
+			ContentType: profile.ContentType,
+			FileName:    profile.FileName,
+			New:         func() VideoProducer { return service.NewProfileReelsService(profile) },
+			Requires: func() Requirements {
+				return Requirements{TTS: true, Fonts: config.FontChain(config.FontRoleRegular), Files: []string{profile.TemplatePath()}}
+			},
+		})
+	}
+	return nil
+}
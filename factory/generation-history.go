@@ -0,0 +1,110 @@
+package factory
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"auto-video-service/repository"
+	"auto-video-service/service"
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"time"
+)
+
+// ProducedRun - 서비스 타입 + 날짜의 영상이 이미 생성되어 파일이 남아 있으면 그 이력을 반환합니다 (없으면 nil)
+// 이력이 없더라도 최종 파일명 규칙의 파일이 있으면 이미 생성된 것으로 봅니다 (이력 기록 이전에 만든 영상).
+func (f *VideoServiceFactory) ProducedRun(ctx context.Context, dateFlag string, serviceType string) (*entity.GenerationRun, error) {
+	_, request, err := f.buildRequest(dateFlag, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	targetDate := request.TargetDate.Format("20060102")
+
+	if config.DatabaseConfigured() {
+		run, err := repository.GenerationRunRepository().FindLatestSucceeded(ctx, serviceType, targetDate)
+		if err != nil {
+			return nil, err
+		}
+		if run != nil && fileExists(run.OutputFile) {
+			return run, nil
+		}
+	}
+
+	if request.OutputFileName != "" && fileExists(request.OutputFileName) {
+		return &entity.GenerationRun{
+			ServiceType: serviceType,
+			TargetDate:  targetDate,
+			Status:      string(enum.RunSucceeded),
+			OutputFile:  request.OutputFileName,
+		}, nil
+	}
+	return nil, nil
+}
+
+// AlreadyProduced - ProducedRun과 같지만 이력 조회에 실패하면 경고만 출력하고 nil을 반환합니다 (건너뛰지 않고 생성)
+// generate, API 서버, worker, scheduler가 이미 생성된 영상을 다시 렌더링하지 않도록 렌더링 전에 호출합니다.
+func (f *VideoServiceFactory) AlreadyProduced(ctx context.Context, dateFlag string, serviceType string) *entity.GenerationRun {
+	run, err := f.ProducedRun(ctx, dateFlag, serviceType)
+	if err != nil {
+		log.Printf("⚠️ 생성 이력 조회 실패 (건너뛰지 않고 생성합니다): %v", err)
+		return nil
+	}
+	return run
+}
+
+// recordRun - 생성 결과를 generation_runs에 기록합니다 (DB 미설정이면 건너뜀, 기록 실패는 경고만 출력)
+func recordRun(ctx context.Context, request dto.VideoCreationRequest, response dto.VideoCreationResponse, produceErr error, elapsed time.Duration) {
+	if !config.DatabaseConfigured() {
+		return
+	}
+
+	run := &entity.GenerationRun{
+		ServiceType: request.ServiceType,
+		TargetDate:  request.TargetDate.Format("20060102"),
+		Status:      string(enum.RunSucceeded),
+		OutputFile:  response.FinalFileName,
+		ElapsedSec:  elapsed.Seconds(),
+		ItemIds:     marshalRunField(response.ItemIds),
+		Options:     marshalRunField(response.Settings),
+	}
+	if produceErr != nil {
+		run.Status = string(enum.RunFailed)
+		run.Error = produceErr.Error()
+	}
+	if run.OutputFile != "" && produceErr == nil {
+		if checksum, err := service.FileChecksum(run.OutputFile); err == nil {
+			run.Checksum = checksum
+		} else {
+			log.Printf("⚠️ 생성 이력 checksum 계산 실패 (%s): %v", run.OutputFile, err)
+		}
+		if duration, err := service.MediaDuration(ctx, run.OutputFile); err == nil {
+			run.DurationSec = duration
+		}
+	}
+
+	// 생성이 취소되었어도 이력은 남기도록 부모 context의 취소와 분리
+	recordCtx := context.WithoutCancel(ctx)
+	if err := repository.GenerationRunRepository().Insert(recordCtx, run); err != nil {
+		log.Printf("⚠️ 생성 이력 기록 실패: %v", err)
+	}
+}
+
+// marshalRunField - 이력 컬럼용 JSON (값이 없으면 빈 문자열)
+func marshalRunField(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+func fileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,103 @@
+package factory
+
+import (
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// VideoProducer - 영상 한 편을 생성하는 서비스가 구현하는 인터페이스
+type VideoProducer interface {
+	Produce(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoCreationResponse, error)
+}
+
+// VideoPreviewer - 슬라이드 이미지 미리보기를 지원하는 서비스가 추가로 구현하는 인터페이스
+type VideoPreviewer interface {
+	Preview(ctx context.Context, request dto.VideoCreationRequest, outputDir string) error
+}
+
+// VideoPlanner - 렌더링 없이 영상 구성(plan)을 계산할 수 있는 서비스가 추가로 구현하는 인터페이스
+type VideoPlanner interface {
+	Plan(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoPlan, error)
+}
+
+// VideoValidator - 렌더링 전에 콘텐츠를 템플릿 기준으로 검증할 수 있는 서비스가 추가로 구현하는 인터페이스
+type VideoValidator interface {
+	Validate(ctx context.Context, request dto.VideoCreationRequest) (dto.ValidationReport, error)
+}
+
+// ProducerSpec - 서비스 타입 코드 하나에 대한 등록 정보
+type ProducerSpec struct {
+	Code        enum.ServiceType
+	Description string
+	Platform    enum.Platform
+	Orientation enum.Orientation
+	ContentType enum.ContentType
+	// FileName - 최종 영상 파일명 규칙 (확장자 제외). {date}는 YYMMDD, {type}은 서비스 코드로 치환됩니다.
+	// 비어 있으면 서비스가 자체 경로를 사용합니다.
+	FileName string
+	New      func() VideoProducer
+	// Requires - 생성에 필요한 외부 도구와 파일 (doctor 검사용). 경로는 config 로드 후에 정해지므로 함수로 계산합니다.
+	Requires func() Requirements
+}
+
+// Requirements - 서비스 타입이 생성에 필요로 하는 외부 도구와 파일
+type Requirements struct {
+	TTS   bool     // say(한국어)와 python3 gTTS(영어) 사용 여부
+	Fonts []string // 폰트 파일 경로
+	Files []string // 템플릿 이미지, 음성 등 그 밖의 파일 경로
+}
+
+// OutputName - 대상 날짜에 대한 최종 파일명(확장자 제외)을 반환합니다
+func (s ProducerSpec) OutputName(targetDate time.Time) string {
+	if s.FileName == "" {
+		return ""
+	}
+	datePrefix := fmt.Sprintf("%02d%02d%02d", targetDate.Year()%100, targetDate.Month(), targetDate.Day())
+	return strings.NewReplacer("{date}", datePrefix, "{type}", string(s.Code)).Replace(s.FileName)
+}
+
+var (
+	producerSpecs = map[enum.ServiceType]ProducerSpec{}
+	producerOrder []enum.ServiceType
+)
+
+// Register - 서비스 타입 코드를 레지스트리에 등록합니다
+// 같은 코드를 두 번 등록하는 것은 프로그래밍 오류이므로 panic 합니다.
+func Register(spec ProducerSpec) {
+	if spec.Code == "" || spec.New == nil {
+		panic("factory: 서비스 코드와 생성 함수는 필수입니다")
+	}
+	if _, exists := producerSpecs[spec.Code]; exists {
+		panic(fmt.Sprintf("factory: 이미 등록된 서비스 타입입니다: %s", spec.Code))
+	}
+	producerSpecs[spec.Code] = spec
+	producerOrder = append(producerOrder, spec.Code)
+}
+
+// Lookup - 서비스 타입 코드에 해당하는 등록 정보를 조회합니다
+func Lookup(code string) (ProducerSpec, bool) {
+	spec, ok := producerSpecs[enum.ServiceType(code)]
+	return spec, ok
+}
+
+// Specs - 등록된 모든 서비스 타입을 등록 순서대로 반환합니다
+func Specs() []ProducerSpec {
+	specs := make([]ProducerSpec, 0, len(producerOrder))
+	for _, code := range producerOrder {
+		specs = append(specs, producerSpecs[code])
+	}
+	return specs
+}
+
+// Codes - 등록된 서비스 타입 코드 목록을 반환합니다
+func Codes() []string {
+	codes := make([]string, 0, len(producerOrder))
+	for _, code := range producerOrder {
+		codes = append(codes, string(code))
+	}
+	return codes
+}
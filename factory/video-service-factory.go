@@ -1,10 +1,12 @@
 package factory
 
 import (
-	"auto-video-service/enum"
-	"auto-video-service/service"
+	"auto-video-service/config"
+	"auto-video-service/dto"
 	"context"
+	"fmt"
 	"log"
+	"path/filepath"
 	"time"
 )
 
@@ -14,42 +16,102 @@ func NewVideoServiceFactory() *VideoServiceFactory {
 	return &VideoServiceFactory{}
 }
 
-func (f *VideoServiceFactory) CreateVideo(ctx context.Context, dateFlag string, serviceType string) {
-	targetDate := f.getTargetDate(dateFlag)
+// CreateVideo - 레지스트리에 등록된 서비스로 영상을 생성합니다
+// 실패 원인은 apperror의 에러(ErrNoContent, ErrTTS, ErrFFmpeg, ErrMissingTemplate, ErrInvalidContent)로 판별할 수 있습니다.
+func (f *VideoServiceFactory) CreateVideo(ctx context.Context, dateFlag string, serviceType string) (dto.VideoCreationResponse, error) {
+	return f.CreateVideoWithOverrides(ctx, dateFlag, serviceType, dto.OptionOverrides{})
+}
 
-	switch enum.ServiceType(serviceType) {
+// CreateVideoWithOverrides - 프로필 옵션(반복 횟수, 속도, 공백)을 작업 단위로 덮어써서 영상을 생성합니다
+// 결과(출력 파일, checksum, 길이, 사용한 콘텐츠 id, 적용 설정)는 DB가 설정되어 있으면 generation_runs에 기록됩니다.
+func (f *VideoServiceFactory) CreateVideoWithOverrides(ctx context.Context, dateFlag string, serviceType string, overrides dto.OptionOverrides) (dto.VideoCreationResponse, error) {
+	spec, request, err := f.buildRequest(dateFlag, serviceType)
+	if err != nil {
+		return dto.VideoCreationResponse{Error: err}, err
+	}
+	request.Overrides = overrides
 
-	case enum.InstagramWord, enum.InstagramIdiom, enum.InstagramSentence:
-		instagramService := service.NewInstagramService()
-		instagramService.CreateReels(ctx, targetDate, serviceType)
+	started := time.Now()
+	response, err := spec.New().Produce(ctx, request)
+	recordRun(ctx, request, response, err, time.Since(started))
+	return response, err
+}
 
-	case enum.FacebookWord, enum.FacebookIdiom, enum.FacebookSentence:
-		facebookService := service.NewFacebookService()
-		facebookService.CreateReels(ctx, targetDate, serviceType)
+// PreviewVideo - 서비스 타입별 슬라이드 이미지만 outputDir에 생성합니다 (음성/영상 생성 없음)
+func (f *VideoServiceFactory) PreviewVideo(ctx context.Context, dateFlag string, serviceType string, outputDir string) error {
+	spec, request, err := f.buildRequest(dateFlag, serviceType)
+	if err != nil {
+		return err
+	}
+	previewer, ok := spec.New().(VideoPreviewer)
+	if !ok {
+		return fmt.Errorf("미리보기를 지원하지 않는 서비스 타입입니다: %s", serviceType)
+	}
+	return previewer.Preview(ctx, request, outputDir)
+}
+
+// PlanVideo - 렌더링 없이 콘텐츠, 슬라이드 텍스트, 클립 순서, 예상 길이, 최종 파일명을 계산합니다
+func (f *VideoServiceFactory) PlanVideo(ctx context.Context, dateFlag string, serviceType string) (dto.VideoPlan, error) {
+	spec, request, err := f.buildRequest(dateFlag, serviceType)
+	if err != nil {
+		return dto.VideoPlan{}, err
+	}
+	planner, ok := spec.New().(VideoPlanner)
+	if !ok {
+		return dto.VideoPlan{}, fmt.Errorf("plan 모드를 지원하지 않는 서비스 타입입니다: %s", serviceType)
+	}
+	return planner.Plan(ctx, request)
+}
 
-	case enum.YoutubeShortsWord, enum.YoutubeShortsIdiom, enum.YoutubeShotsSentence:
-		youtubeShortsService := service.NewYoutubeShortsService()
-		youtubeShortsService.CreateReels(ctx, targetDate, serviceType)
+// ValidateVideo - 렌더링 없이 콘텐츠를 템플릿의 글자 수용 범위와 비교하여 행별 문제를 반환합니다
+func (f *VideoServiceFactory) ValidateVideo(ctx context.Context, dateFlag string, serviceType string) (dto.ValidationReport, error) {
+	spec, request, err := f.buildRequest(dateFlag, serviceType)
+	if err != nil {
+		return dto.ValidationReport{}, err
+	}
+	validator, ok := spec.New().(VideoValidator)
+	if !ok {
+		return dto.ValidationReport{}, fmt.Errorf("콘텐츠 검증을 지원하지 않는 서비스 타입입니다: %s", serviceType)
+	}
+	return validator.Validate(ctx, request)
+}
 
-	case enum.YoutubeLongform:
-		longformService := service.NewLongformWordService()
-		longformService.CreateLongformWords(ctx, targetDate, serviceType)
+// buildRequest - 서비스 타입 등록 정보와 날짜로 생성 요청을 만듭니다
+func (f *VideoServiceFactory) buildRequest(dateFlag string, serviceType string) (ProducerSpec, dto.VideoCreationRequest, error) {
+	spec, ok := Lookup(serviceType)
+	if !ok {
+		return ProducerSpec{}, dto.VideoCreationRequest{}, fmt.Errorf("잘못된 서비스 타입입니다: %s", serviceType)
+	}
+	targetDate, err := f.getTargetDate(dateFlag)
+	if err != nil {
+		return ProducerSpec{}, dto.VideoCreationRequest{}, err
+	}
 
-	case enum.Start:
-		startService := service.NewStartService()
-		startService.CreateStartCommentVideo(ctx, targetDate, serviceType)
+	request := dto.VideoCreationRequest{
+		TargetDate:  targetDate,
+		ServiceType: serviceType,
+		ContentType: spec.ContentType,
+	}
+	if name := spec.OutputName(targetDate); name != "" {
+		request.OutputFileName = filepath.Join(FinalVideoDir(), name+".mp4")
+	}
+	return spec, request, nil
+}
 
-	default:
-		log.Fatalf("잘못된 서비스 타입입니다: %s", serviceType)
+// FinalVideoDir - 최종 영상이 저장되는 디렉토리 (config.json의 Paths.FinalVideoDir, 기본값 final-video)
+func FinalVideoDir() string {
+	if config.Config.Paths.FinalVideoDir != "" {
+		return config.Config.Paths.FinalVideoDir
 	}
+	return "final-video"
 }
 
-func (f *VideoServiceFactory) getTargetDate(dateFlag string) time.Time {
+func (f *VideoServiceFactory) getTargetDate(dateFlag string) (time.Time, error) {
 	var targetDate time.Time
 	if dateFlag != "" {
 		parsedDate, err := time.Parse("20060102", dateFlag)
 		if err != nil {
-			log.Fatalf("날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력하세요: %v", err)
+			return time.Time{}, fmt.Errorf("날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력하세요: %w", err)
 		}
 		targetDate = parsedDate
 		log.Printf("지정된 날짜: %s", targetDate.Format("2006-01-02"))
@@ -57,5 +119,5 @@ func (f *VideoServiceFactory) getTargetDate(dateFlag string) time.Time {
 		targetDate = time.Now()
 		log.Printf("오늘 날짜: %s", targetDate.Format("2006-01-02"))
 	}
-	return targetDate
+	return targetDate, nil
 }
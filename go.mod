@@ -5,6 +5,7 @@ go 1.23.0
 require (
 	github.com/disintegration/imaging v1.6.2
 	github.com/go-sql-driver/mysql v1.7.1
+	github.com/mattn/go-sqlite3 v1.14.33
 )
 
 require (
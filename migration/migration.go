@@ -0,0 +1,173 @@
+package migration
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"auto-video-service/repository"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Migration - 버전 하나의 스키마 변경. Up/Down은 xorm으로 실행하므로 MySQL과 SQLite에서 같은 정의를 사용합니다.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(db config.DatabaseWrapper) error
+	Down    func(db config.DatabaseWrapper) error
+}
+
+// ErrPending - 적용되지 않은 마이그레이션이 있음 ('migrate up' 필요)
+var ErrPending = errors.New("적용되지 않은 마이그레이션이 있습니다")
+
+// Status - 마이그레이션별 적용 여부
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// All - 등록된 마이그레이션 목록 (버전 오름차순)
+func All() []Migration {
+	return migrations
+}
+
+// Statuses - 등록된 마이그레이션의 적용 여부
+func Statuses(ctx context.Context) ([]Status, error) {
+	applied, err := appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(migrations))
+	for _, m := range migrations {
+		record, ok := applied[m.Version]
+		statuses = append(statuses, Status{Migration: m, Applied: ok, AppliedAt: record.AppliedAt})
+	}
+	return statuses, nil
+}
+
+// Up - 적용되지 않은 마이그레이션을 버전 순서대로 적용하고 적용한 목록을 반환합니다
+// 실패하면 그 버전에서 멈추며, 이전 버전까지의 적용 기록은 유지됩니다.
+func Up(ctx context.Context) ([]Migration, error) {
+	applied, err := appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return done, err
+		}
+		log.Printf("⬆️  마이그레이션 적용: %04d %s", m.Version, m.Name)
+		if err := m.Up(config.GetDatabase()); err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d(%s) 적용 실패: %w", m.Version, m.Name, err)
+		}
+		record := &entity.SchemaMigration{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}
+		if err := repository.SchemaMigrationRepository().Insert(ctx, record); err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d 기록 실패: %w", m.Version, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// Bootstrap - 마이그레이션 기록(schema_migrations)이 없는 DB(새 SQLite 파일, 마이그레이션 도입 전 DB)에는 모든 버전을 적용합니다
+// 기록이 있는 DB는 바꾸지 않고 Check 결과를 반환합니다 (migrate down 결과를 되돌리지 않음).
+func Bootstrap(ctx context.Context) ([]Migration, error) {
+	exists, err := config.GetDatabase().IsTableExist(new(entity.SchemaMigration))
+	if err != nil {
+		return nil, fmt.Errorf("마이그레이션 기록 조회 실패: %w", err)
+	}
+	if !exists {
+		return Up(ctx)
+	}
+	return nil, Check(ctx)
+}
+
+// Check - 적용되지 않은 버전이 있으면 ErrPending을 반환합니다. DB를 바꾸지 않으며, 기록 테이블이 없으면 모든 버전이 대기 중입니다.
+func Check(ctx context.Context) error {
+	exists, err := config.GetDatabase().IsTableExist(new(entity.SchemaMigration))
+	if err != nil {
+		return fmt.Errorf("마이그레이션 기록 조회 실패: %w", err)
+	}
+	pending := make([]string, 0)
+	if exists {
+		statuses, err := Statuses(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			if !s.Applied {
+				pending = append(pending, fmt.Sprintf("%04d", s.Version))
+			}
+		}
+	} else {
+		for _, m := range migrations {
+			pending = append(pending, fmt.Sprintf("%04d", m.Version))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w (%s). 'migrate up'을 실행하세요", ErrPending, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Down - 가장 최근에 적용된 마이그레이션부터 steps개를 되돌리고 되돌린 목록을 반환합니다
+func Down(ctx context.Context, steps int) ([]Migration, error) {
+	applied, err := appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return done, err
+		}
+		log.Printf("⬇️  마이그레이션 되돌림: %04d %s", m.Version, m.Name)
+		if err := m.Down(config.GetDatabase()); err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d(%s) 되돌리기 실패: %w", m.Version, m.Name, err)
+		}
+		if err := repository.SchemaMigrationRepository().Delete(ctx, m.Version); err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d 기록 삭제 실패: %w", m.Version, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// appliedVersions - 적용된 버전 기록. 이 바이너리가 모르는 버전이 적용되어 있으면 에러 (더 새 버전으로 마이그레이션된 DB)
+func appliedVersions(ctx context.Context) (map[int]entity.SchemaMigration, error) {
+	repo := repository.SchemaMigrationRepository()
+	if err := repo.EnsureTable(ctx); err != nil {
+		return nil, err
+	}
+	records, err := repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("마이그레이션 기록 조회 실패: %w", err)
+	}
+
+	known := make(map[int]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+	}
+	applied := make(map[int]entity.SchemaMigration, len(records))
+	for _, record := range records {
+		if !known[record.Version] {
+			return nil, fmt.Errorf("알 수 없는 마이그레이션 %04d(%s)이 적용되어 있습니다. 더 새 버전의 프로그램으로 실행하세요", record.Version, record.Name)
+		}
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
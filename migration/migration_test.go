@@ -0,0 +1,105 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"auto-video-service/config"
+	"auto-video-service/entity"
+)
+
+func TestMain(m *testing.M) {
+	config.Config.Database.Driver = config.DriverSQLite
+	config.Config.Database.ConnectionString = ":memory:"
+	config.ConfigureDatabase()
+	code := m.Run()
+	config.CleanUp()
+	os.Exit(code)
+}
+
+func TestVersionsAreIncreasing(t *testing.T) {
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version <= migrations[i-1].Version {
+			t.Fatalf("migration %d (%s) must be greater than %d", migrations[i].Version, migrations[i].Name, migrations[i-1].Version)
+		}
+	}
+}
+
+func TestUpDownStatus(t *testing.T) {
+	ctx := context.Background()
+	db := config.GetDatabase()
+
+	applied, err := Up(ctx)
+	if err != nil || len(applied) != len(migrations) {
+		t.Fatalf("Up = %d, %v", len(applied), err)
+	}
+	if exists, _ := db.IsTableExist("longform_words"); !exists {
+		t.Fatal("longform_words table was not created")
+	}
+	if again, err := Up(ctx); err != nil || len(again) != 0 {
+		t.Fatalf("second Up = %d, %v", len(again), err)
+	}
+
+	last := migrations[len(migrations)-1]
+	reverted, err := Down(ctx, 1)
+	if err != nil || len(reverted) != 1 || reverted[0].Version != last.Version {
+		t.Fatalf("Down = %+v, %v", reverted, err)
+	}
+
+	statuses, err := Statuses(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range statuses {
+		if want := s.Version != last.Version; s.Applied != want {
+			t.Errorf("version %d applied = %v, want %v", s.Version, s.Applied, want)
+		}
+	}
+
+	if applied, err := Up(ctx); err != nil || len(applied) != 1 {
+		t.Fatalf("Up after Down = %d, %v", len(applied), err)
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	ctx := context.Background()
+	db := config.GetDatabase()
+	if _, err := Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if applied, err := Bootstrap(ctx); err != nil || len(applied) != 0 {
+		t.Fatalf("Bootstrap on current DB = %d, %v", len(applied), err)
+	}
+
+	// migrate down 결과는 되돌리지 않고 migrate up을 안내
+	if _, err := Down(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Bootstrap(ctx); !errors.Is(err, ErrPending) {
+		t.Fatalf("Bootstrap after Down = %v, want ErrPending", err)
+	}
+	if err := Check(ctx); !errors.Is(err, ErrPending) {
+		t.Fatalf("Check after Down = %v, want ErrPending", err)
+	}
+	if _, err := Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 마이그레이션 도입 전 DB (테이블은 있고 기록은 없음)는 모든 버전을 기록
+	if _, err := db.Exec("DROP TABLE schema_migrations"); err != nil {
+		t.Fatal(err)
+	}
+	// Check는 기록 테이블을 만들지 않고 모든 버전을 대기 중으로 보고 (MySQL 시작 시 확인)
+	if err := Check(ctx); !errors.Is(err, ErrPending) {
+		t.Fatalf("Check on unversioned DB = %v, want ErrPending", err)
+	}
+	if exists, err := db.IsTableExist(new(entity.SchemaMigration)); err != nil || exists {
+		t.Fatalf("schema_migrations exists after Check = %v, %v", exists, err)
+	}
+	if applied, err := Bootstrap(ctx); err != nil || len(applied) != len(migrations) {
+		t.Fatalf("Bootstrap on unversioned DB = %d, %v", len(applied), err)
+	}
+}
@@ -0,0 +1,234 @@
+package migration
+
+import (
+	"auto-video-service/config"
+	"database/sql"
+	"time"
+)
+
+// migrations - 버전 순서대로 추가합니다. 이미 배포된 버전은 수정하지 말고 새 버전을 추가하세요.
+// 각 버전은 그 시점의 테이블 구조(아래 스냅샷 구조체)를 Sync2로 만들므로, 이후 entity가 바뀌어도 과거 버전의 결과는 달라지지 않습니다.
+// Sync2는 이미 있는 테이블/컬럼을 건너뛰므로 스키마를 수동으로 만든 기존 DB에도 적용할 수 있습니다.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create content tables",
+		Up: func(db config.DatabaseWrapper) error {
+			return db.Sync2(new(englishWordV1), new(englishIdiomV1), new(shortSentenceV1), new(longformWordV1), new(titleV1))
+		},
+		Down: func(db config.DatabaseWrapper) error {
+			return db.DropTables(new(englishWordV1), new(englishIdiomV1), new(shortSentenceV1), new(longformWordV1), new(titleV1))
+		},
+	},
+	{
+		Version: 2,
+		Name:    "create generation_jobs",
+		Up: func(db config.DatabaseWrapper) error {
+			return db.Sync2(new(generationJobV2))
+		},
+		Down: func(db config.DatabaseWrapper) error {
+			return db.DropTables(new(generationJobV2))
+		},
+	},
+	{
+		Version: 3,
+		Name:    "create schedule_runs",
+		Up: func(db config.DatabaseWrapper) error {
+			return db.Sync2(new(scheduleRunV3))
+		},
+		Down: func(db config.DatabaseWrapper) error {
+			return db.DropTables(new(scheduleRunV3))
+		},
+	},
+	{
+		Version: 4,
+		Name:    "create generation_runs",
+		Up: func(db config.DatabaseWrapper) error {
+			return db.Sync2(new(generationRunV4))
+		},
+		Down: func(db config.DatabaseWrapper) error {
+			return db.DropTables(new(generationRunV4))
+		},
+	},
+	{
+		Version: 5,
+		Name:    "add sort_order to content tables",
+		Up: func(db config.DatabaseWrapper) error {
+			return db.Sync2(new(englishWordV5), new(englishIdiomV5), new(shortSentenceV5), new(longformWordV5))
+		},
+		Down: func(db config.DatabaseWrapper) error {
+			for _, table := range []string{"english_words", "english_idioms", "short_sentences", "longform_words"} {
+				if _, err := db.Exec("ALTER TABLE " + table + " DROP COLUMN sort_order"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// 1: 콘텐츠 테이블
+
+type englishWordV1 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	EnglishWord     string `xorm:"english_word varchar(255)"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	CreatedDate     string `xorm:"created_date varchar(8) index"`
+}
+
+func (englishWordV1) TableName() string { return "english_words" }
+
+type englishIdiomV1 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	Idiom           string `xorm:"idiom varchar(255)"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	CreatedDate     string `xorm:"created_date varchar(8) index"`
+}
+
+func (englishIdiomV1) TableName() string { return "english_idioms" }
+
+type shortSentenceV1 struct {
+	Id               int64          `xorm:"id pk autoincr"`
+	KoreanSentence1  string         `xorm:"korean_sentence_1 varchar(500) notnull"`
+	KoreanSentence2  sql.NullString `xorm:"korean_sentence_2 varchar(500)"`
+	EnglishSentence1 string         `xorm:"english_sentence_1 varchar(500) notnull"`
+	EnglishSentence2 sql.NullString `xorm:"english_sentence_2 varchar(500)"`
+	Pronunciation    string         `xorm:"pronunciation varchar(500) notnull"`
+	CreatedDate      string         `xorm:"created_date varchar(8) notnull index"`
+}
+
+func (shortSentenceV1) TableName() string { return "short_sentences" }
+
+type longformWordV1 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	Word            string `xorm:"word varchar(255) notnull"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	Source          string `xorm:"source varchar(100) notnull"`
+	CreatedDate     string `xorm:"created_date varchar(8) notnull index"`
+	ShortsDate      string `xorm:"shorts_date varchar(8) index"`
+	ContentType     string `xorm:"content_type varchar(20)"`
+}
+
+func (longformWordV1) TableName() string { return "longform_words" }
+
+type titleV1 struct {
+	Id          int64  `xorm:"id pk autoincr"`
+	Title       string `xorm:"title varchar(255) notnull"`
+	SubTitle    string `xorm:"sub_title varchar(255) notnull"`
+	CreatedDate string `xorm:"created_date varchar(8) notnull index"`
+	IsUploaded  bool   `xorm:"is_uploaded"`
+}
+
+func (titleV1) TableName() string { return "title" }
+
+// 2: 작업 대기열
+
+type generationJobV2 struct {
+	Id          int64     `xorm:"id pk autoincr"`
+	ServiceType string    `xorm:"service_type varchar(20) notnull"`
+	TargetDate  string    `xorm:"target_date varchar(8) notnull"`
+	Options     string    `xorm:"options text"`
+	Status      string    `xorm:"status varchar(20) notnull index"`
+	Attempts    int       `xorm:"attempts notnull default 0"`
+	MaxAttempts int       `xorm:"max_attempts notnull default 3"`
+	LastError   string    `xorm:"last_error text"`
+	OutputFile  string    `xorm:"output_file varchar(255)"`
+	AvailableAt time.Time `xorm:"available_at notnull index"`
+	LockedBy    string    `xorm:"locked_by varchar(100)"`
+	LockedAt    time.Time `xorm:"locked_at"`
+	FinishedAt  time.Time `xorm:"finished_at"`
+	CreatedAt   time.Time `xorm:"created_at created"`
+	UpdatedAt   time.Time `xorm:"updated_at updated"`
+}
+
+func (generationJobV2) TableName() string { return "generation_jobs" }
+
+// 3: scheduler 실행 기록
+
+type scheduleRunV3 struct {
+	Name           string    `xorm:"name varchar(100) pk"`
+	LastRunAt      time.Time `xorm:"last_run_at notnull"`
+	LastTargetDate string    `xorm:"last_target_date varchar(8)"`
+	UpdatedAt      time.Time `xorm:"updated_at updated"`
+}
+
+func (scheduleRunV3) TableName() string { return "schedule_runs" }
+
+// 4: 영상 생성 이력
+
+type generationRunV4 struct {
+	Id          int64     `xorm:"id pk autoincr"`
+	ServiceType string    `xorm:"service_type varchar(20) notnull index(idx_generation_runs_type_date)"`
+	TargetDate  string    `xorm:"target_date varchar(8) notnull index(idx_generation_runs_type_date)"`
+	Status      string    `xorm:"status varchar(20) notnull"`
+	OutputFile  string    `xorm:"output_file varchar(255)"`
+	Checksum    string    `xorm:"checksum varchar(64)"`
+	DurationSec float64   `xorm:"duration_sec"`
+	ElapsedSec  float64   `xorm:"elapsed_sec"`
+	ItemIds     string    `xorm:"item_ids text"`
+	Options     string    `xorm:"options text"`
+	Error       string    `xorm:"error text"`
+	CreatedAt   time.Time `xorm:"created_at created"`
+}
+
+func (generationRunV4) TableName() string { return "generation_runs" }
+
+// 5: 콘텐츠 순서 (1의 테이블 + sort_order)
+
+type englishWordV5 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	EnglishWord     string `xorm:"english_word varchar(255)"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	CreatedDate     string `xorm:"created_date varchar(8) index"`
+	SortOrder       int    `xorm:"sort_order notnull default 0"`
+}
+
+func (englishWordV5) TableName() string { return "english_words" }
+
+type englishIdiomV5 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	Idiom           string `xorm:"idiom varchar(255)"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	CreatedDate     string `xorm:"created_date varchar(8) index"`
+	SortOrder       int    `xorm:"sort_order notnull default 0"`
+}
+
+func (englishIdiomV5) TableName() string { return "english_idioms" }
+
+type shortSentenceV5 struct {
+	Id               int64          `xorm:"id pk autoincr"`
+	KoreanSentence1  string         `xorm:"korean_sentence_1 varchar(500) notnull"`
+	KoreanSentence2  sql.NullString `xorm:"korean_sentence_2 varchar(500)"`
+	EnglishSentence1 string         `xorm:"english_sentence_1 varchar(500) notnull"`
+	EnglishSentence2 sql.NullString `xorm:"english_sentence_2 varchar(500)"`
+	Pronunciation    string         `xorm:"pronunciation varchar(500) notnull"`
+	CreatedDate      string         `xorm:"created_date varchar(8) notnull index"`
+	SortOrder        int            `xorm:"sort_order notnull default 0"`
+}
+
+func (shortSentenceV5) TableName() string { return "short_sentences" }
+
+type longformWordV5 struct {
+	Id              int64  `xorm:"id pk autoincr"`
+	Word            string `xorm:"word varchar(255) notnull"`
+	Meaning         string `xorm:"meaning varchar(255) notnull"`
+	PronunciationKr string `xorm:"pronunciation_kr varchar(255)"`
+	PhoneticSymbol  string `xorm:"phonetic_symbol varchar(255)"`
+	Source          string `xorm:"source varchar(100) notnull"`
+	CreatedDate     string `xorm:"created_date varchar(8) notnull index"`
+	ShortsDate      string `xorm:"shorts_date varchar(8) index"`
+	ContentType     string `xorm:"content_type varchar(20)"`
+	SortOrder       int    `xorm:"sort_order notnull default 0"`
+}
+
+func (longformWordV5) TableName() string { return "longform_words" }
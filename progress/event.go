@@ -0,0 +1,55 @@
+package progress
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventKind - 진행 이벤트 종류
+type EventKind string
+
+const (
+	EventJobStart   EventKind = "job_start"
+	EventStageStart EventKind = "stage_start"
+	EventItemDone   EventKind = "item_done"
+	EventStageDone  EventKind = "stage_done"
+	EventJobDone    EventKind = "job_done"
+	EventJobFailed  EventKind = "job_failed"
+)
+
+// 단계 이름
+const (
+	StageContent = "content" // 콘텐츠 조회
+	StageTitle   = "title"   // 롱폼 타이틀 이미지/음성/영상
+	StageImages  = "images"  // 슬라이드 이미지
+	StageRender  = "render"  // 항목별 음성 + 클립
+	StageConcat  = "concat"  // 최종 영상 합치기
+)
+
+// Event - 진행 이벤트 하나
+type Event struct {
+	Time    time.Time
+	Kind    EventKind
+	Job     string // "<타입> <날짜>"
+	Stage   string
+	Index   int // 완료된 항목 수 (item_done)
+	Total   int // 단계의 전체 항목 수 (0이면 항목 단위 진행률 없음)
+	Elapsed time.Duration
+	ETA     time.Duration // 남은 예상 시간 (item_done에서만 계산)
+	Error   string
+}
+
+// MarshalJSON - 시간 값은 초 단위 실수로 출력합니다
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Time       time.Time `json:"time"`
+		Kind       EventKind `json:"kind"`
+		Job        string    `json:"job"`
+		Stage      string    `json:"stage,omitempty"`
+		Index      int       `json:"index,omitempty"`
+		Total      int       `json:"total,omitempty"`
+		ElapsedSec float64   `json:"elapsed_sec"`
+		ETASec     float64   `json:"eta_sec,omitempty"`
+		Error      string    `json:"error,omitempty"`
+	}{e.Time, e.Kind, e.Job, e.Stage, e.Index, e.Total, e.Elapsed.Seconds(), e.ETA.Seconds(), e.Error})
+}
@@ -0,0 +1,216 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Sink - 진행 이벤트를 받는 출력 대상. Emit은 여러 goroutine에서 동시에 호출될 수 있습니다.
+type Sink interface {
+	Emit(event Event)
+	Close() error
+}
+
+// Discard - 이벤트를 버리는 Sink
+var Discard Sink = discardSink{}
+
+type discardSink struct{}
+
+func (discardSink) Emit(Event)   {}
+func (discardSink) Close() error { return nil }
+
+// ConsoleSink - 사람이 읽기 좋은 한 줄 형식으로 출력합니다
+type ConsoleSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewConsoleSink(w io.Writer) *ConsoleSink {
+	return &ConsoleSink{w: w}
+}
+
+func (s *ConsoleSink) Emit(event Event) {
+	line := FormatEvent(event)
+	if line == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprintln(s.w, line)
+}
+
+func (s *ConsoleSink) Close() error { return nil }
+
+// FormatEvent - 콘솔 출력 형식 (예: "[iw 20261017] render 3/10 (30%) 경과 0:12, 남은 시간 약 0:28")
+func FormatEvent(event Event) string {
+	prefix := fmt.Sprintf("[%s]", event.Job)
+	elapsed := formatClock(event.Elapsed)
+	switch event.Kind {
+	case EventJobStart:
+		return fmt.Sprintf("%s ▶ 시작", prefix)
+	case EventStageStart:
+		if event.Total > 0 {
+			return fmt.Sprintf("%s %s 시작 (%d개)", prefix, event.Stage, event.Total)
+		}
+		return fmt.Sprintf("%s %s 시작", prefix, event.Stage)
+	case EventItemDone:
+		if event.Total == 0 {
+			return ""
+		}
+		line := fmt.Sprintf("%s %s %d/%d (%d%%) 경과 %s", prefix, event.Stage, event.Index, event.Total, event.Index*100/event.Total, elapsed)
+		if event.ETA > 0 {
+			line += fmt.Sprintf(", 남은 시간 약 %s", formatClock(event.ETA))
+		}
+		return line
+	case EventStageDone:
+		return fmt.Sprintf("%s %s 완료 (경과 %s)", prefix, event.Stage, elapsed)
+	case EventJobDone:
+		return fmt.Sprintf("%s ✔ 완료 (%s)", prefix, elapsed)
+	case EventJobFailed:
+		return fmt.Sprintf("%s ✘ 실패 (%s): %s", prefix, elapsed, event.Error)
+	}
+	return ""
+}
+
+// formatClock - 분:초 (1시간 이상이면 시:분:초)
+func formatClock(d time.Duration) string {
+	d = d.Round(time.Second)
+	h, m, s := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// JSONLinesSink - 이벤트를 한 줄에 하나씩 JSON으로 출력합니다
+type JSONLinesSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// NewJSONLinesSink - w가 io.Closer면 Close 시 함께 닫습니다
+func NewJSONLinesSink(w io.Writer) *JSONLinesSink {
+	closer, _ := w.(io.Closer)
+	return &JSONLinesSink{encoder: json.NewEncoder(w), closer: closer}
+}
+
+func (s *JSONLinesSink) Emit(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.encoder.Encode(event); err != nil {
+		log.Printf("진행 이벤트 기록 실패: %v", err)
+	}
+}
+
+func (s *JSONLinesSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+const (
+	httpSinkBuffer  = 256
+	httpSinkTimeout = 5 * time.Second
+)
+
+// HTTPSink - 이벤트마다 JSON을 URL로 POST합니다
+// 전송은 별도 goroutine에서 순서대로 하므로 렌더링을 막지 않습니다. 콜백 서버가 느리거나 응답하지 않아 버퍼가 가득 차면
+// 이벤트 종류와 관계없이 버리고 개수만 셉니다 (Dropped, Close 시 로그).
+type HTTPSink struct {
+	url     string
+	client  *http.Client
+	events  chan Event
+	done    chan struct{}
+	once    sync.Once
+	dropped atomic.Int64
+}
+
+func NewHTTPSink(url string) *HTTPSink {
+	s := &HTTPSink{
+		url:    url,
+		client: &http.Client{Timeout: httpSinkTimeout},
+		events: make(chan Event, httpSinkBuffer),
+		done:   make(chan struct{}),
+	}
+	go s.loop()
+	return s
+}
+
+func (s *HTTPSink) Emit(event Event) {
+	select {
+	case s.events <- event:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Dropped - 버퍼가 가득 차서 보내지 못하고 버린 이벤트 수
+func (s *HTTPSink) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close - 버퍼에 남은 이벤트를 모두 보낸 뒤 종료합니다
+func (s *HTTPSink) Close() error {
+	s.once.Do(func() { close(s.events) })
+	<-s.done
+	if dropped := s.Dropped(); dropped > 0 {
+		log.Printf("진행 이벤트 %d건은 전송이 밀려 보내지 못했습니다 (%s)", dropped, s.url)
+	}
+	return nil
+}
+
+func (s *HTTPSink) loop() {
+	defer close(s.done)
+	failed := false
+	for event := range s.events {
+		if err := s.post(event); err != nil && !failed {
+			// 콜백 서버 장애 시 로그가 넘치지 않도록 첫 실패만 기록
+			log.Printf("진행 이벤트 전송 실패 (%s): %v", s.url, err)
+			failed = true
+		}
+	}
+}
+
+func (s *HTTPSink) post(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("응답 코드 %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// MultiSink - 여러 Sink로 같은 이벤트를 보냅니다
+type MultiSink []Sink
+
+func (m MultiSink) Emit(event Event) {
+	for _, sink := range m {
+		sink.Emit(event)
+	}
+}
+
+func (m MultiSink) Close() error {
+	var errs []error
+	for _, sink := range m {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,37 @@
+package progress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPSinkDropsInsteadOfBlocking(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL)
+	emitted := make(chan struct{})
+	go func() {
+		for i := 0; i < httpSinkBuffer+10; i++ {
+			sink.Emit(Event{Kind: EventStageDone, Job: "iw 20261017"})
+		}
+		close(emitted)
+	}()
+
+	select {
+	case <-emitted:
+	case <-time.After(time.Second):
+		t.Fatal("Emit blocked while the webhook was not responding")
+	}
+	if sink.Dropped() == 0 {
+		t.Error("Expected dropped events when the buffer is full")
+	}
+
+	close(release)
+	sink.Close()
+}
@@ -0,0 +1,157 @@
+package progress
+
+import (
+	"auto-video-service/dto"
+	"context"
+	"sync"
+	"time"
+)
+
+// Tracker - 작업 하나의 진행 상황을 Sink로 보내고 단계별 소요 시간을 모읍니다
+// nil Tracker의 메서드는 아무 일도 하지 않으므로, 서비스는 Tracker 유무와 관계없이 호출할 수 있습니다.
+type Tracker struct {
+	job     string
+	sink    Sink
+	started time.Time
+
+	mu     sync.Mutex
+	stages []dto.StageTiming
+}
+
+// NewTracker - job은 이벤트에 표시할 작업 이름 (예: "iw 20261017")
+func NewTracker(job string, sink Sink) *Tracker {
+	if sink == nil {
+		sink = Discard
+	}
+	return &Tracker{job: job, sink: sink, started: time.Now()}
+}
+
+// Start - 작업 시작 이벤트
+func (t *Tracker) Start() {
+	if t == nil {
+		return
+	}
+	t.started = time.Now()
+	t.emit(Event{Kind: EventJobStart})
+}
+
+// Finish - 작업 완료/실패 이벤트
+func (t *Tracker) Finish(err error) {
+	if t == nil {
+		return
+	}
+	if err != nil {
+		t.emit(Event{Kind: EventJobFailed, Error: err.Error()})
+		return
+	}
+	t.emit(Event{Kind: EventJobDone})
+}
+
+// Stage - 단계를 시작합니다. total이 0보다 크면 Done() 호출마다 진행률과 남은 시간을 보냅니다.
+func (t *Tracker) Stage(name string, total int) *Stage {
+	if t == nil {
+		return nil
+	}
+	stage := &Stage{tracker: t, name: name, total: total, started: time.Now()}
+	t.emit(Event{Kind: EventStageStart, Stage: name, Total: total})
+	return stage
+}
+
+// Stages - 지금까지 끝난 단계별 소요 시간 (같은 이름의 단계가 여러 번 실행되면 합산)
+func (t *Tracker) Stages() []dto.StageTiming {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]dto.StageTiming(nil), t.stages...)
+}
+
+// Elapsed - 작업 시작 후 경과 시간
+func (t *Tracker) Elapsed() time.Duration {
+	if t == nil {
+		return 0
+	}
+	return time.Since(t.started)
+}
+
+func (t *Tracker) emit(event Event) {
+	event.Time = time.Now()
+	event.Job = t.job
+	event.Elapsed = event.Time.Sub(t.started)
+	t.sink.Emit(event)
+}
+
+func (t *Tracker) recordStage(name string, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := range t.stages {
+		if t.stages[i].Name == name {
+			t.stages[i].DurationSec += duration.Seconds()
+			return
+		}
+	}
+	t.stages = append(t.stages, dto.StageTiming{Name: name, DurationSec: duration.Seconds()})
+}
+
+// Stage - 진행 중인 단계. Done은 여러 goroutine에서 동시에 호출할 수 있습니다.
+type Stage struct {
+	tracker *Tracker
+	name    string
+	total   int
+	started time.Time
+
+	mu    sync.Mutex
+	done  int
+	ended bool
+}
+
+// Done - 항목 하나 완료
+func (s *Stage) Done() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.done++
+	done := s.done
+	s.mu.Unlock()
+
+	event := Event{Kind: EventItemDone, Stage: s.name, Index: done, Total: s.total}
+	if s.total > 0 && done < s.total {
+		// 지금까지의 항목당 평균 시간으로 남은 시간 추정
+		perItem := time.Since(s.started) / time.Duration(done)
+		event.ETA = perItem * time.Duration(s.total-done)
+	}
+	s.tracker.emit(event)
+}
+
+// End - 단계 종료 (여러 번 호출해도 한 번만 기록)
+func (s *Stage) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	done := s.done
+	s.mu.Unlock()
+
+	s.tracker.recordStage(s.name, time.Since(s.started))
+	s.tracker.emit(Event{Kind: EventStageDone, Stage: s.name, Index: done, Total: s.total})
+}
+
+type trackerKey struct{}
+
+// WithTracker - ctx에 Tracker를 담습니다. 서비스는 FromContext로 꺼내 씁니다.
+func WithTracker(ctx context.Context, tracker *Tracker) context.Context {
+	return context.WithValue(ctx, trackerKey{}, tracker)
+}
+
+// FromContext - ctx에 담긴 Tracker (없으면 nil - 메서드 호출은 안전)
+func FromContext(ctx context.Context) *Tracker {
+	tracker, _ := ctx.Value(trackerKey{}).(*Tracker)
+	return tracker
+}
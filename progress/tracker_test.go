@@ -0,0 +1,75 @@
+package progress
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// recordSink - 받은 이벤트를 모아두는 테스트용 Sink
+type recordSink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *recordSink) Emit(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *recordSink) Close() error { return nil }
+
+func TestTrackerEmitsStageProgress(t *testing.T) {
+	sink := &recordSink{}
+	tracker := NewTracker("iw 20261017", sink)
+	tracker.Start()
+
+	stage := tracker.Stage(StageRender, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stage.Done()
+		}()
+	}
+	wg.Wait()
+	stage.End()
+	stage.End()
+	tracker.Finish(nil)
+
+	kinds := map[EventKind]int{}
+	maxIndex := 0
+	for _, event := range sink.events {
+		kinds[event.Kind]++
+		if event.Job != "iw 20261017" {
+			t.Errorf("event job = %q", event.Job)
+		}
+		if event.Kind == EventItemDone && event.Index > maxIndex {
+			maxIndex = event.Index
+		}
+	}
+	if kinds[EventJobStart] != 1 || kinds[EventStageStart] != 1 || kinds[EventItemDone] != 3 || kinds[EventStageDone] != 1 || kinds[EventJobDone] != 1 {
+		t.Errorf("unexpected event counts: %v", kinds)
+	}
+	if maxIndex != 3 {
+		t.Errorf("last item index = %d, want 3", maxIndex)
+	}
+
+	stages := tracker.Stages()
+	if len(stages) != 1 || stages[0].Name != StageRender {
+		t.Errorf("Stages() = %v, want one render stage", stages)
+	}
+}
+
+func TestNilTrackerIsNoop(t *testing.T) {
+	tracker := FromContext(context.Background())
+	stage := tracker.Stage(StageImages, 2)
+	stage.Done()
+	stage.End()
+	tracker.Finish(nil)
+	if tracker.Stages() != nil {
+		t.Errorf("nil tracker returned stages")
+	}
+}
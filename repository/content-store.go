@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"context"
+	"fmt"
+)
+
+// EnglishWordStore - 영어단어(english_words) 조회
+type EnglishWordStore interface {
+	FindById(ctx context.Context, id int64) (entity.EnglishWord, error)
+	FindByToday(ctx context.Context) ([]entity.EnglishWord, error)
+	FindByDate(ctx context.Context, dateStr string) ([]entity.EnglishWord, error)
+	FindByDateRange(ctx context.Context, from string, to string) ([]entity.EnglishWord, error)
+}
+
+// EnglishIdiomStore - 영어숙어(english_idioms) 조회
+type EnglishIdiomStore interface {
+	FindById(ctx context.Context, id int64) (entity.EnglishIdiom, error)
+	FindByToday(ctx context.Context) ([]entity.EnglishIdiom, error)
+	FindByDate(ctx context.Context, dateStr string) ([]entity.EnglishIdiom, error)
+	FindByDateRange(ctx context.Context, from string, to string) ([]entity.EnglishIdiom, error)
+}
+
+// ShortSentenceStore - 단문(short_sentences) 조회
+type ShortSentenceStore interface {
+	FindByDate(ctx context.Context, dateStr string) ([]entity.ShortSentence, error)
+	FindByDateRange(ctx context.Context, from string, to string) ([]entity.ShortSentence, error)
+}
+
+// LongformWordStore - 롱폼 단어(longform_words) 조회와 유튜브 숏폼 일정(shorts_date, content_type) 지정
+type LongformWordStore interface {
+	FindByDate(ctx context.Context, dateStr string) ([]entity.LongformWord, error)
+	FindByDateRange(ctx context.Context, from string, to string) ([]entity.LongformWord, error)
+	FindByShortsDate(ctx context.Context, dateStr string) ([]entity.LongformWord, error)
+	FindByShortsDateAndContentType(ctx context.Context, dateStr string, contentType string) ([]entity.LongformWord, error)
+	FindUnscheduled(ctx context.Context) ([]entity.LongformWord, error)
+	FindByShortsDateRange(ctx context.Context, from string, to string) ([]entity.LongformWord, error)
+	AssignShorts(ctx context.Context, words []entity.LongformWord) (int64, error)
+}
+
+// TitleStore - 롱폼 타이틀(title) 조회. 해당 날짜가 없으면 apperror.ErrNoContent
+type TitleStore interface {
+	FindByDate(ctx context.Context, dateStr string) (*entity.Title, error)
+}
+
+// InsertContent - 콘텐츠 행(entity 슬라이스 포인터)을 한 트랜잭션으로 저장합니다. 하나라도 실패하면 모두 취소합니다.
+func InsertContent(ctx context.Context, rows ...interface{}) (int64, error) {
+	db := config.GetDatabase()
+	session := db.NewSession().Context(ctx)
+	defer session.Close()
+
+	if err := session.Begin(); err != nil {
+		return 0, err
+	}
+	inserted, err := session.Insert(rows...)
+	if err != nil {
+		session.Rollback()
+		return 0, fmt.Errorf("콘텐츠 저장 실패: %w", err)
+	}
+	if err := session.Commit(); err != nil {
+		return 0, err
+	}
+	return inserted, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	config.Config.Database.Driver = "sqlite"
+	config.Config.Database.ConnectionString = ":memory:"
+	config.ConfigureDatabase()
+	// 스키마는 migration 패키지가 관리하지만 repository 테스트에서는 import할 수 없으므로 entity로 직접 생성
+	err := config.GetDatabase().Sync2(
+		new(entity.EnglishWord), new(entity.EnglishIdiom), new(entity.ShortSentence), new(entity.LongformWord),
+		new(entity.Title), new(entity.GenerationJob), new(entity.ScheduleRun), new(entity.GenerationRun),
+	)
+	if err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	config.CleanUp()
+	os.Exit(code)
+}
+
+func TestSQLiteContentStores(t *testing.T) {
+	ctx := context.Background()
+	db := config.GetDatabase()
+
+	_, err := db.Insert(
+		&entity.EnglishWord{EnglishWord: "apple", Meaning: "사과", CreatedDate: "20250101"},
+		&entity.EnglishWord{EnglishWord: "banana", Meaning: "바나나", CreatedDate: "20250102"},
+		&entity.ShortSentence{KoreanSentence1: "안녕", EnglishSentence1: "Hello", EnglishSentence2: sql.NullString{String: "there", Valid: true}, Pronunciation: "헬로", CreatedDate: "20250101"},
+		&entity.LongformWord{Word: "run", Meaning: "달리다", Source: "test", CreatedDate: "20250101", ShortsDate: "20250105", ContentType: "word"},
+	)
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	var words EnglishWordStore = EnglishWordRepository()
+	found, err := words.FindByDate(ctx, "20250101")
+	if err != nil || len(found) != 1 || found[0].EnglishWord != "apple" {
+		t.Fatalf("FindByDate = %+v, %v", found, err)
+	}
+
+	sentences, err := ShortSentenceRepository().FindByDate(ctx, "20250101")
+	if err != nil || len(sentences) != 1 || !sentences[0].EnglishSentence2.Valid || sentences[0].KoreanSentence2.Valid {
+		t.Fatalf("short sentences = %+v, %v", sentences, err)
+	}
+
+	longform, err := LongformWordRepository().FindByShortsDateAndContentType(ctx, "20250105", "word")
+	if err != nil || len(longform) != 1 {
+		t.Fatalf("longform words = %+v, %v", longform, err)
+	}
+
+	if _, err := TitleRepository().FindByDate(ctx, "20250101"); !errors.Is(err, apperror.ErrNoContent) {
+		t.Fatalf("missing title error = %v, want ErrNoContent", err)
+	}
+}
+
+func TestFindByDateHonorsSortOrder(t *testing.T) {
+	ctx := context.Background()
+	db := config.GetDatabase()
+
+	_, err := db.Insert(
+		&entity.EnglishIdiom{Idiom: "third", Meaning: "셋", CreatedDate: "20250301", SortOrder: 3},
+		&entity.EnglishIdiom{Idiom: "first", Meaning: "하나", CreatedDate: "20250301", SortOrder: 1},
+		&entity.EnglishIdiom{Idiom: "second-a", Meaning: "둘", CreatedDate: "20250301", SortOrder: 2},
+		&entity.EnglishIdiom{Idiom: "second-b", Meaning: "둘", CreatedDate: "20250301", SortOrder: 2},
+	)
+	if err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	idioms, err := EnglishIdiomRepository().FindByDate(ctx, "20250301")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"first", "second-a", "second-b", "third"}
+	if len(idioms) != len(want) {
+		t.Fatalf("idioms = %+v", idioms)
+	}
+	for i, idiom := range idioms {
+		if idiom.Idiom != want[i] {
+			t.Errorf("idioms[%d] = %s, want %s", i, idiom.Idiom, want[i])
+		}
+	}
+}
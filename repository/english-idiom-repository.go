@@ -14,7 +14,7 @@ var (
 	englishIdiomRepositoryInstance *englishIdiomRepository
 )
 
-func EnglishIdiomRepository() *englishIdiomRepository {
+func EnglishIdiomRepository() EnglishIdiomStore {
 	englishIdiomRepositoryOnce.Do(func() {
 		englishIdiomRepositoryInstance = &englishIdiomRepository{}
 	})
@@ -28,7 +28,7 @@ type englishIdiomRepository struct {
 func (englishIdiomRepository) FindById(ctx context.Context, id int64) (entity.EnglishIdiom, error) {
 	db := config.GetDatabase()
 	var englishIdiom entity.EnglishIdiom
-	q := db.Table("english_idioms").Where("id=?", id)
+	q := db.Context(ctx).Table("english_idioms").Where("id=?", id)
 
 	has, err := q.Get(&englishIdiom)
 	if err != nil {
@@ -48,7 +48,7 @@ func (englishIdiomRepository) FindByToday(ctx context.Context) ([]entity.English
 	var englishIdioms []entity.EnglishIdiom
 	today := time.Now().Format("20060102")
 
-	err := db.Table("english_idioms").Where("created_date = ?", today).Find(&englishIdioms)
+	err := db.Context(ctx).Table("english_idioms").Where("created_date = ?", today).Asc("sort_order", "id").Find(&englishIdioms)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,24 @@ func (englishIdiomRepository) FindByDate(ctx context.Context, dateStr string) ([
 	db := config.GetDatabase()
 	var englishIdioms []entity.EnglishIdiom
 
-	err := db.Table("english_idioms").Where("created_date = ?", dateStr).Find(&englishIdioms)
+	err := db.Context(ctx).Table("english_idioms").Where("created_date = ?", dateStr).Asc("sort_order", "id").Find(&englishIdioms)
+	if err != nil {
+		return nil, err
+	}
+
+	return englishIdioms, nil
+}
+
+// FindByDateRange - created_date가 from ~ to(포함)인 데이터 (날짜, id 순)
+func (englishIdiomRepository) FindByDateRange(ctx context.Context, from string, to string) ([]entity.EnglishIdiom, error) {
+	db := config.GetDatabase()
+	var englishIdioms []entity.EnglishIdiom
+
+	err := db.Context(ctx).Table("english_idioms").
+		Where("created_date >= ?", from).
+		And("created_date <= ?", to).
+		Asc("created_date", "sort_order", "id").
+		Find(&englishIdioms)
 	if err != nil {
 		return nil, err
 	}
@@ -14,7 +14,7 @@ var (
 	englishWordRepositoryInstance *englishWordRepository
 )
 
-func EnglishWordRepository() *englishWordRepository {
+func EnglishWordRepository() EnglishWordStore {
 	englishWordRepositoryOnce.Do(func() {
 		englishWordRepositoryInstance = &englishWordRepository{}
 	})
@@ -28,7 +28,7 @@ type englishWordRepository struct {
 func (englishWordRepository) FindById(ctx context.Context, id int64) (entity.EnglishWord, error) {
 	db := config.GetDatabase()
 	var englishWord entity.EnglishWord
-	q := db.Table("english_words").Where("id=?", id)
+	q := db.Context(ctx).Table("english_words").Where("id=?", id)
 
 	has, err := q.Get(&englishWord)
 	if err != nil {
@@ -48,7 +48,7 @@ func (englishWordRepository) FindByToday(ctx context.Context) ([]entity.EnglishW
 	var englishWords []entity.EnglishWord
 	today := time.Now().Format("20060102")
 
-	err := db.Table("english_words").Where("created_date = ?", today).Find(&englishWords)
+	err := db.Context(ctx).Table("english_words").Where("created_date = ?", today).Asc("sort_order", "id").Find(&englishWords)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,24 @@ func (englishWordRepository) FindByDate(ctx context.Context, dateStr string) ([]
 	db := config.GetDatabase()
 	var englishWords []entity.EnglishWord
 
-	err := db.Table("english_words").Where("created_date = ?", dateStr).Find(&englishWords)
+	err := db.Context(ctx).Table("english_words").Where("created_date = ?", dateStr).Asc("sort_order", "id").Find(&englishWords)
+	if err != nil {
+		return nil, err
+	}
+
+	return englishWords, nil
+}
+
+// FindByDateRange - created_date가 from ~ to(포함)인 데이터 (날짜, id 순)
+func (englishWordRepository) FindByDateRange(ctx context.Context, from string, to string) ([]entity.EnglishWord, error) {
+	db := config.GetDatabase()
+	var englishWords []entity.EnglishWord
+
+	err := db.Context(ctx).Table("english_words").
+		Where("created_date >= ?", from).
+		And("created_date <= ?", to).
+		Asc("created_date", "sort_order", "id").
+		Find(&englishWords)
 	if err != nil {
 		return nil, err
 	}
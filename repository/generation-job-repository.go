@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	generationJobRepositoryOnce     sync.Once
+	generationJobRepositoryInstance *generationJobRepository
+)
+
+func GenerationJobRepository() *generationJobRepository {
+	generationJobRepositoryOnce.Do(func() {
+		generationJobRepositoryInstance = &generationJobRepository{}
+	})
+
+	return generationJobRepositoryInstance
+}
+
+type generationJobRepository struct{}
+
+// staleJobError - 실행 중 worker가 종료되어 시도 횟수를 모두 쓴 작업의 last_error
+const staleJobError = "worker lost: 실행 중 worker가 종료되어 최대 시도 횟수를 모두 사용했습니다"
+
+// Enqueue - 작업을 대기 상태로 등록합니다
+func (r *generationJobRepository) Enqueue(ctx context.Context, job *entity.GenerationJob) error {
+	db := config.GetDatabase()
+	job.Status = string(enum.JobQueued)
+	if job.AvailableAt.IsZero() {
+		job.AvailableAt = time.Now()
+	}
+	_, err := db.Context(ctx).Table("generation_jobs").Insert(job)
+	return err
+}
+
+// FindByID - ID로 작업 조회 (없으면 nil)
+func (r *generationJobRepository) FindByID(ctx context.Context, id int64) (*entity.GenerationJob, error) {
+	db := config.GetDatabase()
+	var job entity.GenerationJob
+	has, err := db.Context(ctx).Table("generation_jobs").Where("id = ?", id).Get(&job)
+	if err != nil || !has {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindRecent - 최근 작업 목록 (status가 비어있으면 전체)
+func (r *generationJobRepository) FindRecent(ctx context.Context, status string, limit int) ([]entity.GenerationJob, error) {
+	db := config.GetDatabase()
+	var jobs []entity.GenerationJob
+
+	session := db.Context(ctx).Table("generation_jobs")
+	if status != "" {
+		session = session.Where("status = ?", status)
+	}
+	err := session.Desc("id").Limit(limit).Find(&jobs)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ClaimNext - 실행 가능한 가장 오래된 대기 작업을 workerID 소유로 가져옵니다 (없으면 nil)
+// 조건부 UPDATE(status = queued)로 선점하므로 여러 worker가 동시에 실행해도 같은 작업을 두 번 가져가지 않습니다.
+func (r *generationJobRepository) ClaimNext(ctx context.Context, workerID string, now time.Time) (*entity.GenerationJob, error) {
+	db := config.GetDatabase()
+
+	for {
+		var candidate entity.GenerationJob
+		has, err := db.Context(ctx).Table("generation_jobs").
+			Where("status = ?", string(enum.JobQueued)).
+			And("available_at <= ?", now).
+			Asc("available_at", "id").
+			Get(&candidate)
+		if err != nil || !has {
+			return nil, err
+		}
+
+		affected, err := db.Context(ctx).Table("generation_jobs").
+			Where("id = ?", candidate.Id).
+			And("status = ?", string(enum.JobQueued)).
+			Incr("attempts").
+			Cols("status", "locked_by", "locked_at").
+			Update(&entity.GenerationJob{Status: string(enum.JobRunning), LockedBy: workerID, LockedAt: now})
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			// 다른 worker가 먼저 가져감 - 다음 후보 조회
+			continue
+		}
+		return r.FindByID(ctx, candidate.Id)
+	}
+}
+
+// MarkDone - 작업 완료 처리 (해당 worker가 소유한 경우만)
+func (r *generationJobRepository) MarkDone(ctx context.Context, id int64, workerID string, outputFile string, now time.Time) error {
+	return r.finish(ctx, id, workerID, &entity.GenerationJob{Status: string(enum.JobDone), OutputFile: outputFile, FinishedAt: now}, "status", "output_file", "finished_at", "last_error")
+}
+
+// MarkFailed - 재시도하지 않는 실패 처리
+func (r *generationJobRepository) MarkFailed(ctx context.Context, id int64, workerID string, lastError string, now time.Time) error {
+	return r.finish(ctx, id, workerID, &entity.GenerationJob{Status: string(enum.JobFailed), LastError: lastError, FinishedAt: now}, "status", "last_error", "finished_at")
+}
+
+// ScheduleRetry - availableAt 이후에 다시 실행되도록 대기 상태로 되돌립니다
+func (r *generationJobRepository) ScheduleRetry(ctx context.Context, id int64, workerID string, lastError string, availableAt time.Time) error {
+	return r.finish(ctx, id, workerID, &entity.GenerationJob{Status: string(enum.JobQueued), LastError: lastError, AvailableAt: availableAt}, "status", "last_error", "available_at", "locked_by")
+}
+
+// Release - 종료 요청으로 중단된 작업을 시도 횟수 차감 후 바로 대기 상태로 되돌립니다
+func (r *generationJobRepository) Release(ctx context.Context, id int64, workerID string, now time.Time) error {
+	db := config.GetDatabase()
+	_, err := db.Context(ctx).Table("generation_jobs").
+		Where("id = ?", id).
+		And("status = ?", string(enum.JobRunning)).
+		And("locked_by = ?", workerID).
+		Decr("attempts").
+		Cols("status", "available_at", "locked_by").
+		Update(&entity.GenerationJob{Status: string(enum.JobQueued), AvailableAt: now})
+	return err
+}
+
+// RequeueStale - staleBefore 이전에 선점된 채 남아있는 실행 중 작업(worker 비정상 종료)을 대기 상태로 되돌립니다
+// 시도 횟수를 모두 쓴 작업은 되돌리지 않고 실패 처리하므로, worker를 죽이는 작업이 대기열을 계속 돌지 않습니다.
+func (r *generationJobRepository) RequeueStale(ctx context.Context, staleBefore time.Time, now time.Time) (requeued int64, failed int64, err error) {
+	db := config.GetDatabase()
+	failed, err = db.Context(ctx).Table("generation_jobs").
+		Where("status = ?", string(enum.JobRunning)).
+		And("locked_at < ?", staleBefore).
+		And("attempts >= max_attempts").
+		Cols("status", "last_error", "finished_at").
+		Update(&entity.GenerationJob{Status: string(enum.JobFailed), LastError: staleJobError, FinishedAt: now})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	requeued, err = db.Context(ctx).Table("generation_jobs").
+		Where("status = ?", string(enum.JobRunning)).
+		And("locked_at < ?", staleBefore).
+		And("attempts < max_attempts").
+		Cols("status", "available_at", "locked_by").
+		Update(&entity.GenerationJob{Status: string(enum.JobQueued), AvailableAt: now})
+	return requeued, failed, err
+}
+
+func (r *generationJobRepository) finish(ctx context.Context, id int64, workerID string, bean *entity.GenerationJob, cols ...string) error {
+	db := config.GetDatabase()
+	affected, err := db.Context(ctx).Table("generation_jobs").
+		Where("id = ?", id).
+		And("status = ?", string(enum.JobRunning)).
+		And("locked_by = ?", workerID).
+		Cols(cols...).
+		Update(bean)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("작업 #%d의 소유권이 없습니다 (다른 worker가 다시 가져갔을 수 있음)", id)
+	}
+	return nil
+}
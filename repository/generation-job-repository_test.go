@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// resetJobs - generation_jobs를 비우고 jobs를 순서대로 등록합니다
+func resetJobs(t *testing.T, jobs ...*entity.GenerationJob) {
+	t.Helper()
+	if _, err := config.GetDatabase().Exec("DELETE FROM generation_jobs"); err != nil {
+		t.Fatal(err)
+	}
+	for _, job := range jobs {
+		if job.MaxAttempts == 0 {
+			job.MaxAttempts = 3
+		}
+		if err := GenerationJobRepository().Enqueue(context.Background(), job); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func mustFindJob(t *testing.T, id int64) *entity.GenerationJob {
+	t.Helper()
+	job, err := GenerationJobRepository().FindByID(context.Background(), id)
+	if err != nil || job == nil {
+		t.Fatalf("FindByID(%d) = %v, %v", id, job, err)
+	}
+	return job
+}
+
+func TestClaimNextNeverReturnsSameJob(t *testing.T) {
+	ctx := context.Background()
+	repo := GenerationJobRepository()
+	past := time.Now().Add(-time.Minute)
+	jobs := make([]*entity.GenerationJob, 5)
+	for i := range jobs {
+		jobs[i] = &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260101", AvailableAt: past}
+	}
+	resetJobs(t, jobs...)
+
+	var mu sync.Mutex
+	claimed := make(map[int64]string)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(workerID string) {
+			defer wg.Done()
+			for {
+				job, err := repo.ClaimNext(ctx, workerID, time.Now())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if job == nil {
+					return
+				}
+				mu.Lock()
+				if owner, ok := claimed[job.Id]; ok {
+					t.Errorf("job %d claimed by %s and %s", job.Id, owner, workerID)
+				}
+				claimed[job.Id] = workerID
+				mu.Unlock()
+				if job.Status != string(enum.JobRunning) || job.LockedBy != workerID || job.Attempts != 1 {
+					t.Errorf("claimed job = %+v", job)
+				}
+			}
+		}(string(rune('a' + w)))
+	}
+	wg.Wait()
+
+	if len(claimed) != len(jobs) {
+		t.Fatalf("claimed %d jobs, want %d", len(claimed), len(jobs))
+	}
+}
+
+func TestReleaseAndScheduleRetry(t *testing.T) {
+	ctx := context.Background()
+	repo := GenerationJobRepository()
+	now := time.Now()
+	resetJobs(t, &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260101", AvailableAt: now.Add(-time.Minute)})
+
+	job, err := repo.ClaimNext(ctx, "w1", now)
+	if err != nil || job == nil {
+		t.Fatalf("ClaimNext = %v, %v", job, err)
+	}
+	if err := repo.Release(ctx, job.Id, "w1", now); err != nil {
+		t.Fatal(err)
+	}
+	released := mustFindJob(t, job.Id)
+	if released.Status != string(enum.JobQueued) || released.Attempts != 0 || released.LockedBy != "" {
+		t.Fatalf("released job = %+v, want queued with attempts 0", released)
+	}
+
+	job, err = repo.ClaimNext(ctx, "w1", now)
+	if err != nil || job == nil || job.Attempts != 1 {
+		t.Fatalf("ClaimNext after Release = %+v, %v", job, err)
+	}
+	if err := repo.ScheduleRetry(ctx, job.Id, "w1", "tts", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if next, err := repo.ClaimNext(ctx, "w2", now); err != nil || next != nil {
+		t.Fatalf("ClaimNext before retry time = %+v, %v, want nil", next, err)
+	}
+	retried, err := repo.ClaimNext(ctx, "w2", now.Add(2*time.Hour))
+	if err != nil || retried == nil || retried.Attempts != 2 || retried.LastError != "tts" {
+		t.Fatalf("ClaimNext after retry time = %+v, %v", retried, err)
+	}
+}
+
+func TestFinishRequiresOwnership(t *testing.T) {
+	ctx := context.Background()
+	repo := GenerationJobRepository()
+	now := time.Now()
+	resetJobs(t, &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260101", AvailableAt: now.Add(-time.Minute)})
+
+	job, err := repo.ClaimNext(ctx, "owner", now)
+	if err != nil || job == nil {
+		t.Fatalf("ClaimNext = %v, %v", job, err)
+	}
+	if err := repo.MarkDone(ctx, job.Id, "other", "out.mp4", now); err == nil {
+		t.Fatal("MarkDone by another worker succeeded")
+	}
+	if err := repo.Release(ctx, job.Id, "other", now); err != nil {
+		t.Fatal(err)
+	}
+	if got := mustFindJob(t, job.Id); got.Status != string(enum.JobRunning) || got.Attempts != 1 {
+		t.Fatalf("job after foreign Release = %+v, want still running", got)
+	}
+
+	if err := repo.MarkDone(ctx, job.Id, "owner", "out.mp4", now); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.MarkFailed(ctx, job.Id, "owner", "late", now); err == nil {
+		t.Fatal("MarkFailed after MarkDone succeeded")
+	}
+	if got := mustFindJob(t, job.Id); got.Status != string(enum.JobDone) || got.OutputFile != "out.mp4" {
+		t.Fatalf("finished job = %+v", got)
+	}
+}
+
+func TestRequeueStaleRespectsMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	repo := GenerationJobRepository()
+	now := time.Now()
+	retryable := &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260101", AvailableAt: now.Add(-time.Hour), MaxAttempts: 2}
+	exhausted := &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260102", AvailableAt: now.Add(-time.Hour), MaxAttempts: 1}
+	fresh := &entity.GenerationJob{ServiceType: "iw", TargetDate: "20260103", AvailableAt: now.Add(-time.Hour)}
+	resetJobs(t, retryable, exhausted, fresh)
+
+	// retryable과 exhausted는 오래전에 선점된 뒤 worker가 죽은 상태, fresh는 실행 중
+	lockedAt := now.Add(-time.Hour)
+	for _, id := range []int64{retryable.Id, exhausted.Id} {
+		if job, err := repo.ClaimNext(ctx, "dead", lockedAt); err != nil || job == nil || job.Id != id {
+			t.Fatalf("ClaimNext = %+v, %v, want job %d", job, err, id)
+		}
+	}
+	if job, err := repo.ClaimNext(ctx, "alive", now); err != nil || job == nil || job.Id != fresh.Id {
+		t.Fatalf("ClaimNext = %+v, %v, want job %d", job, err, fresh.Id)
+	}
+
+	requeued, failed, err := repo.RequeueStale(ctx, now.Add(-time.Minute), now)
+	if err != nil || requeued != 1 || failed != 1 {
+		t.Fatalf("RequeueStale = %d, %d, %v, want 1, 1", requeued, failed, err)
+	}
+	if got := mustFindJob(t, retryable.Id); got.Status != string(enum.JobQueued) || got.LockedBy != "" {
+		t.Errorf("retryable job = %+v, want queued", got)
+	}
+	if got := mustFindJob(t, exhausted.Id); got.Status != string(enum.JobFailed) || got.LastError != staleJobError {
+		t.Errorf("exhausted job = %+v, want failed with %q", got, staleJobError)
+	}
+	if got := mustFindJob(t, fresh.Id); got.Status != string(enum.JobRunning) || got.LockedBy != "alive" {
+		t.Errorf("fresh job = %+v, want still running", got)
+	}
+}
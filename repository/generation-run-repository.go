@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"context"
+	"sync"
+)
+
+var (
+	generationRunRepositoryOnce     sync.Once
+	generationRunRepositoryInstance *generationRunRepository
+)
+
+func GenerationRunRepository() *generationRunRepository {
+	generationRunRepositoryOnce.Do(func() {
+		generationRunRepositoryInstance = &generationRunRepository{}
+	})
+
+	return generationRunRepositoryInstance
+}
+
+type generationRunRepository struct{}
+
+// Insert - 생성 이력 기록
+func (r *generationRunRepository) Insert(ctx context.Context, run *entity.GenerationRun) error {
+	db := config.GetDatabase()
+	_, err := db.Context(ctx).Table("generation_runs").Insert(run)
+	return err
+}
+
+// FindLatestSucceeded - 서비스 타입 + 날짜의 가장 최근 성공 이력 (없으면 nil)
+func (r *generationRunRepository) FindLatestSucceeded(ctx context.Context, serviceType string, targetDate string) (*entity.GenerationRun, error) {
+	db := config.GetDatabase()
+	var run entity.GenerationRun
+	has, err := db.Context(ctx).Table("generation_runs").
+		Where("service_type = ?", serviceType).
+		And("target_date = ?", targetDate).
+		And("status = ?", string(enum.RunSucceeded)).
+		Desc("id").
+		Get(&run)
+	if err != nil || !has {
+		return nil, err
+	}
+	return &run, nil
+}
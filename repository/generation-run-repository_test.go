@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"context"
+	"testing"
+)
+
+func TestFindLatestSucceeded(t *testing.T) {
+	ctx := context.Background()
+	runs := GenerationRunRepository()
+
+	for _, run := range []*entity.GenerationRun{
+		{ServiceType: "iw", TargetDate: "20250101", Status: string(enum.RunSucceeded), OutputFile: "old.mp4"},
+		{ServiceType: "iw", TargetDate: "20250101", Status: string(enum.RunSucceeded), OutputFile: "new.mp4", ItemIds: "[1,2]"},
+		{ServiceType: "iw", TargetDate: "20250101", Status: string(enum.RunFailed), Error: "boom"},
+		{ServiceType: "yl", TargetDate: "20250101", Status: string(enum.RunFailed), Error: "boom"},
+	} {
+		if err := runs.Insert(ctx, run); err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	}
+
+	latest, err := runs.FindLatestSucceeded(ctx, "iw", "20250101")
+	if err != nil || latest == nil || latest.OutputFile != "new.mp4" || latest.ItemIds != "[1,2]" {
+		t.Fatalf("FindLatestSucceeded(iw) = %+v, %v", latest, err)
+	}
+	if latest, err := runs.FindLatestSucceeded(ctx, "yl", "20250101"); err != nil || latest != nil {
+		t.Fatalf("FindLatestSucceeded(yl) = %+v, %v, want nil", latest, err)
+	}
+}
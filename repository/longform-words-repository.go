@@ -4,6 +4,7 @@ import (
 	"auto-video-service/config"
 	"auto-video-service/entity"
 	"context"
+	"fmt"
 	"sync"
 )
 
@@ -12,7 +13,7 @@ var (
 	longformWordRepositoryInstance *longformWordRepository
 )
 
-func LongformWordRepository() *longformWordRepository {
+func LongformWordRepository() LongformWordStore {
 	longformWordRepositoryOnce.Do(func() {
 		longformWordRepositoryInstance = &longformWordRepository{}
 	})
@@ -26,7 +27,7 @@ func (r *longformWordRepository) FindByDate(ctx context.Context, dateStr string)
 	db := config.GetDatabase()
 	var longformWords []entity.LongformWord
 
-	err := db.Table("longform_words").Where("created_date = ?", dateStr).Find(&longformWords)
+	err := db.Context(ctx).Table("longform_words").Where("created_date = ?", dateStr).Asc("sort_order", "id").Find(&longformWords)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +41,7 @@ func (r *longformWordRepository) FindByShortsDate(ctx context.Context, dateStr s
 	var longformWords []entity.LongformWord
 
 	// shorts_date 컬럼을 기준으로 조회
-	err := db.Table("longform_words").Where("shorts_date = ?", dateStr).Find(&longformWords)
+	err := db.Context(ctx).Table("longform_words").Where("shorts_date = ?", dateStr).Asc("sort_order", "id").Find(&longformWords)
 	if err != nil {
 		return nil, err
 	}
@@ -53,9 +54,93 @@ func (r *longformWordRepository) FindByShortsDateAndContentType(ctx context.Cont
 	db := config.GetDatabase()
 	var longformWords []entity.LongformWord
 
-	err := db.Table("longform_words").
+	err := db.Context(ctx).Table("longform_words").
 		Where("shorts_date = ?", dateStr).
 		And("content_type = ?", contentType).
+		Asc("sort_order", "id").
+		Find(&longformWords)
+	if err != nil {
+		return nil, err
+	}
+
+	return longformWords, nil
+}
+
+// FindUnscheduled - 유튜브 숏폼 날짜가 지정되지 않은 롱폼 단어 (created_date, id 순)
+func (r *longformWordRepository) FindUnscheduled(ctx context.Context) ([]entity.LongformWord, error) {
+	db := config.GetDatabase()
+	var longformWords []entity.LongformWord
+
+	err := db.Context(ctx).Table("longform_words").
+		Where("(shorts_date IS NULL OR shorts_date = '')").
+		Asc("created_date", "sort_order", "id").
+		Find(&longformWords)
+	if err != nil {
+		return nil, err
+	}
+
+	return longformWords, nil
+}
+
+// FindByShortsDateRange - 유튜브 숏폼 날짜가 from ~ to(포함)인 롱폼 단어
+func (r *longformWordRepository) FindByShortsDateRange(ctx context.Context, from string, to string) ([]entity.LongformWord, error) {
+	db := config.GetDatabase()
+	var longformWords []entity.LongformWord
+
+	err := db.Context(ctx).Table("longform_words").
+		Where("shorts_date >= ?", from).
+		And("shorts_date <= ?", to).
+		Asc("shorts_date", "sort_order", "id").
+		Find(&longformWords)
+	if err != nil {
+		return nil, err
+	}
+
+	return longformWords, nil
+}
+
+// AssignShorts - 각 행(id 기준)의 shorts_date, content_type을 한 트랜잭션으로 지정합니다
+// 그 사이 다른 곳에서 날짜가 지정된 행이 있으면 모두 취소합니다.
+func (r *longformWordRepository) AssignShorts(ctx context.Context, words []entity.LongformWord) (int64, error) {
+	db := config.GetDatabase()
+	session := db.NewSession().Context(ctx)
+	defer session.Close()
+
+	if err := session.Begin(); err != nil {
+		return 0, err
+	}
+	var updated int64
+	for _, word := range words {
+		affected, err := session.Table("longform_words").
+			Where("id = ?", word.Id).
+			And("(shorts_date IS NULL OR shorts_date = '')").
+			Cols("shorts_date", "content_type").
+			Update(&entity.LongformWord{ShortsDate: word.ShortsDate, ContentType: word.ContentType})
+		if err != nil {
+			session.Rollback()
+			return 0, fmt.Errorf("숏폼 일정 저장 실패 (id=%d): %w", word.Id, err)
+		}
+		if affected != 1 {
+			session.Rollback()
+			return 0, fmt.Errorf("숏폼 일정 저장 실패 (id=%d): 이미 날짜가 지정되었거나 없는 행입니다", word.Id)
+		}
+		updated += affected
+	}
+	if err := session.Commit(); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// FindByDateRange - created_date가 from ~ to(포함)인 데이터 (날짜, id 순)
+func (r *longformWordRepository) FindByDateRange(ctx context.Context, from string, to string) ([]entity.LongformWord, error) {
+	db := config.GetDatabase()
+	var longformWords []entity.LongformWord
+
+	err := db.Context(ctx).Table("longform_words").
+		Where("created_date >= ?", from).
+		And("created_date <= ?", to).
+		Asc("created_date", "sort_order", "id").
 		Find(&longformWords)
 	if err != nil {
 		return nil, err
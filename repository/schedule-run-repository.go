@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	scheduleRunRepositoryOnce     sync.Once
+	scheduleRunRepositoryInstance *scheduleRunRepository
+)
+
+func ScheduleRunRepository() *scheduleRunRepository {
+	scheduleRunRepositoryOnce.Do(func() {
+		scheduleRunRepositoryInstance = &scheduleRunRepository{}
+	})
+
+	return scheduleRunRepositoryInstance
+}
+
+type scheduleRunRepository struct{}
+
+// FindByName - 항목의 마지막 성공 기록 조회 (한 번도 성공하지 않았으면 nil)
+func (r *scheduleRunRepository) FindByName(ctx context.Context, name string) (*entity.ScheduleRun, error) {
+	db := config.GetDatabase()
+	var run entity.ScheduleRun
+	has, err := db.Context(ctx).Table("schedule_runs").Where("name = ?", name).Get(&run)
+	if err != nil || !has {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// RecordSuccess - 항목의 마지막 성공 기록을 갱신합니다. 더 이전 실행이 늦게 끝나도 기록이 되돌아가지 않습니다.
+func (r *scheduleRunRepository) RecordSuccess(ctx context.Context, name string, runAt time.Time, targetDate string) error {
+	db := config.GetDatabase()
+	session := db.Context(ctx).Table("schedule_runs")
+
+	affected, err := session.
+		Where("name = ?", name).
+		And("last_run_at < ?", runAt).
+		Cols("last_run_at", "last_target_date").
+		Update(&entity.ScheduleRun{LastRunAt: runAt, LastTargetDate: targetDate})
+	if err != nil || affected > 0 {
+		return err
+	}
+
+	existing, err := r.FindByName(ctx, name)
+	if err != nil || existing != nil {
+		return err
+	}
+	_, err = db.Context(ctx).Table("schedule_runs").Insert(&entity.ScheduleRun{Name: name, LastRunAt: runAt, LastTargetDate: targetDate})
+	return err
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/entity"
+	"context"
+	"fmt"
+	"sync"
+)
+
+var (
+	schemaMigrationRepositoryOnce     sync.Once
+	schemaMigrationRepositoryInstance *schemaMigrationRepository
+)
+
+func SchemaMigrationRepository() *schemaMigrationRepository {
+	schemaMigrationRepositoryOnce.Do(func() {
+		schemaMigrationRepositoryInstance = &schemaMigrationRepository{}
+	})
+
+	return schemaMigrationRepositoryInstance
+}
+
+type schemaMigrationRepository struct{}
+
+// EnsureTable - schema_migrations 테이블이 없으면 생성합니다
+func (r *schemaMigrationRepository) EnsureTable(ctx context.Context) error {
+	db := config.GetDatabase()
+	if err := db.Sync2(new(entity.SchemaMigration)); err != nil {
+		return fmt.Errorf("schema_migrations 테이블 생성 실패: %w", err)
+	}
+	return nil
+}
+
+// FindAll - 적용된 마이그레이션 목록 (버전 오름차순)
+func (r *schemaMigrationRepository) FindAll(ctx context.Context) ([]entity.SchemaMigration, error) {
+	db := config.GetDatabase()
+	var migrations []entity.SchemaMigration
+	if err := db.Context(ctx).Table("schema_migrations").Asc("version").Find(&migrations); err != nil {
+		return nil, err
+	}
+	return migrations, nil
+}
+
+// Insert - 마이그레이션 적용 기록
+func (r *schemaMigrationRepository) Insert(ctx context.Context, migration *entity.SchemaMigration) error {
+	db := config.GetDatabase()
+	_, err := db.Context(ctx).Table("schema_migrations").Insert(migration)
+	return err
+}
+
+// Delete - 마이그레이션 적용 기록 삭제 (down)
+func (r *schemaMigrationRepository) Delete(ctx context.Context, version int) error {
+	db := config.GetDatabase()
+	_, err := db.Context(ctx).Table("schema_migrations").Where("version = ?", version).Delete(&entity.SchemaMigration{})
+	return err
+}
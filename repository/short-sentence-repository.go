@@ -13,7 +13,7 @@ var (
 )
 
 // ShortSentenceRepository returns the singleton instance of the short sentence repository.
-func ShortSentenceRepository() *shortSentenceRepository {
+func ShortSentenceRepository() ShortSentenceStore {
 	shortSentenceRepositoryOnce.Do(func() {
 		shortSentenceRepositoryInstance = &shortSentenceRepository{}
 	})
@@ -28,10 +28,27 @@ func (r *shortSentenceRepository) FindByDate(ctx context.Context, dateStr string
 	db := config.GetDatabase()
 	var sentences []entity.ShortSentence
 
-	err := db.Table("short_sentences").Where("created_date = ?", dateStr).Find(&sentences)
+	err := db.Context(ctx).Table("short_sentences").Where("created_date = ?", dateStr).Asc("sort_order", "id").Find(&sentences)
 	if err != nil {
 		return nil, err
 	}
 
 	return sentences, nil
 }
+
+// FindByDateRange - created_date가 from ~ to(포함)인 데이터 (날짜, id 순)
+func (r *shortSentenceRepository) FindByDateRange(ctx context.Context, from string, to string) ([]entity.ShortSentence, error) {
+	db := config.GetDatabase()
+	var shortSentences []entity.ShortSentence
+
+	err := db.Context(ctx).Table("short_sentences").
+		Where("created_date >= ?", from).
+		And("created_date <= ?", to).
+		Asc("created_date", "sort_order", "id").
+		Find(&shortSentences)
+	if err != nil {
+		return nil, err
+	}
+
+	return shortSentences, nil
+}
@@ -1,10 +1,11 @@
 package repository
 
 import (
+	"auto-video-service/apperror"
 	"auto-video-service/config"
 	"auto-video-service/entity"
 	"context"
-	"errors"
+	"fmt"
 	"sync"
 )
 
@@ -13,7 +14,7 @@ var (
 	titleRepositoryInstance *titleRepository
 )
 
-func TitleRepository() *titleRepository {
+func TitleRepository() TitleStore {
 	titleRepositoryOnce.Do(func() {
 		titleRepositoryInstance = &titleRepository{}
 	})
@@ -26,12 +27,12 @@ type titleRepository struct{}
 func (r *titleRepository) FindByDate(ctx context.Context, dateStr string) (*entity.Title, error) {
 	db := config.GetDatabase()
 	var title entity.Title
-	has, err := db.Table("title").Where("created_date = ?", dateStr).Get(&title)
+	has, err := db.Context(ctx).Table("title").Where("created_date = ?", dateStr).Get(&title)
 	if err != nil {
 		return nil, err
 	}
 	if !has {
-		return nil, errors.New("해당 날짜의 타이틀을 찾을 수 없습니다")
+		return nil, fmt.Errorf("%w: 해당 날짜의 타이틀을 찾을 수 없습니다", apperror.ErrNoContent)
 	}
 	return &title, nil
 }
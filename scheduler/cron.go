@@ -0,0 +1,154 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMacros - 자주 쓰는 표현의 약어
+var cronMacros = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// Cron - 5필드 cron 표현식 (분 시 일 월 요일)
+// 각 필드는 *, 숫자, 범위(1-5), 목록(1,3,5), 간격(*/15, 0-30/10)을 지원합니다. 요일은 0(일요일)~6, 7도 일요일로 취급합니다.
+type Cron struct {
+	expr     string
+	minutes  [60]bool
+	hours    [24]bool
+	days     [32]bool
+	months   [13]bool
+	weekdays [7]bool
+	// 일/요일 중 하나만 제한되어 있으면 그 필드만 보고, 둘 다 제한되어 있으면 둘 중 하나만 맞아도 실행 (표준 cron 규칙)
+	dayRestricted     bool
+	weekdayRestricted bool
+}
+
+// ParseCron - cron 표현식을 해석합니다
+func ParseCron(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	spec := expr
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron 표현식은 5개 필드(분 시 일 월 요일)여야 합니다 (입력값: %q)", expr)
+	}
+
+	c := &Cron{expr: expr}
+	var err error
+	if _, err = parseCronField(fields[0], 0, 59, c.minutes[:]); err != nil {
+		return nil, fmt.Errorf("분 필드 %w", err)
+	}
+	if _, err = parseCronField(fields[1], 0, 23, c.hours[:]); err != nil {
+		return nil, fmt.Errorf("시 필드 %w", err)
+	}
+	if c.dayRestricted, err = parseCronField(fields[2], 1, 31, c.days[:]); err != nil {
+		return nil, fmt.Errorf("일 필드 %w", err)
+	}
+	if _, err = parseCronField(fields[3], 1, 12, c.months[:]); err != nil {
+		return nil, fmt.Errorf("월 필드 %w", err)
+	}
+
+	var weekdays [8]bool
+	if c.weekdayRestricted, err = parseCronField(fields[4], 0, 7, weekdays[:]); err != nil {
+		return nil, fmt.Errorf("요일 필드 %w", err)
+	}
+	copy(c.weekdays[:], weekdays[:7])
+	c.weekdays[0] = c.weekdays[0] || weekdays[7]
+
+	return c, nil
+}
+
+// parseCronField - 필드 하나를 해석하여 허용되는 값을 set에 표시합니다. *로 시작하지 않으면 restricted=true
+func parseCronField(field string, min int, max int, set []bool) (restricted bool, err error) {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return false, fmt.Errorf("간격 값이 올바르지 않습니다: %q", part)
+			}
+		}
+
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return false, fmt.Errorf("값이 올바르지 않습니다: %q", part)
+			}
+			if hi, err = strconv.Atoi(bounds[1]); err != nil {
+				return false, fmt.Errorf("값이 올바르지 않습니다: %q", part)
+			}
+			restricted = true
+		default:
+			if lo, err = strconv.Atoi(rangePart); err != nil {
+				return false, fmt.Errorf("값이 올바르지 않습니다: %q", part)
+			}
+			hi = lo
+			if step > 1 {
+				hi = max
+			}
+			restricted = true
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return false, fmt.Errorf("범위(%d-%d)를 벗어났습니다: %q", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return restricted, nil
+}
+
+// String - 원래 표현식
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next - after 이후(after 제외) 처음으로 일정에 맞는 시각을 반환합니다 (분 단위, after의 시간대 기준)
+// 5년 안에 맞는 시각이 없으면(예: 2월 30일) 0값을 반환합니다.
+func (c *Cron) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !c.months[t.Month()] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) matchDay(t time.Time) bool {
+	dayMatch := c.days[t.Day()]
+	weekdayMatch := c.weekdays[t.Weekday()]
+	if c.dayRestricted && c.weekdayRestricted {
+		return dayMatch || weekdayMatch
+	}
+	return dayMatch && weekdayMatch
+}
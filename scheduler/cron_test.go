@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	base := time.Date(2026, 10, 17, 6, 30, 0, 0, time.UTC) // 토요일
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"0 5 * * *", time.Date(2026, 10, 18, 5, 0, 0, 0, time.UTC)},
+		{"45 6 * * *", time.Date(2026, 10, 17, 6, 45, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2026, 10, 17, 6, 40, 0, 0, time.UTC)},
+		{"0 9 * * 1", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)}, // 일/요일 둘 다 지정 시 OR
+		{"@weekly", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		cron, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseCron(%q) failed: %v", tt.expr, err)
+		}
+		if got := cron.Next(base); !got.Equal(tt.want) {
+			t.Errorf("Next(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronRejectsInvalid(t *testing.T) {
+	for _, expr := range []string{"", "0 5 * *", "60 * * * *", "0 24 * * *", "0 5 * * 8", "*/0 * * * *", "5-1 * * * *"} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) succeeded, want error", expr)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package scheduler
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/factory"
+	"auto-video-service/repository"
+	"auto-video-service/worker"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// entry - 해석된 schedules 항목
+type entry struct {
+	config.ScheduleConfig
+	cron *Cron
+	next time.Time
+}
+
+// Scheduler - cron 일정에 따라 영상을 생성하거나 대기열에 등록합니다
+type Scheduler struct {
+	entries      []*entry
+	videoFactory *factory.VideoServiceFactory
+}
+
+// NewScheduler - schedules 설정을 검증하고 Scheduler를 생성합니다
+func NewScheduler(schedules []config.ScheduleConfig, videoFactory *factory.VideoServiceFactory) (*Scheduler, error) {
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("schedules 설정이 비어있습니다")
+	}
+	if err := config.ValidateSchedules(schedules); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*entry, 0, len(schedules))
+	for _, sc := range schedules {
+		cron, err := ParseCron(sc.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("schedules (%s): %w", sc.Name, err)
+		}
+		for _, serviceType := range sc.ServiceTypes() {
+			if _, ok := factory.Lookup(serviceType); !ok {
+				return nil, fmt.Errorf("schedules (%s): type 값이 올바르지 않습니다 (입력값: %s). 허용된 타입: %s", sc.Name, serviceType, strings.Join(factory.Codes(), ", "))
+			}
+		}
+		entries = append(entries, &entry{ScheduleConfig: sc, cron: cron})
+	}
+	return &Scheduler{entries: entries, videoFactory: videoFactory}, nil
+}
+
+// Status - 항목별 마지막 성공/다음 실행 시각
+type Status struct {
+	Name      string
+	Cron      string
+	Types     []string
+	Mode      string
+	LastRunAt time.Time // 한 번도 성공하지 않았으면 0값
+	NextRunAt time.Time
+	Missed    []time.Time // 다음 시작 시 다시 실행할 놓친 실행 시각
+}
+
+// Statuses - 현재 시각 기준 항목별 상태를 반환합니다
+func (s *Scheduler) Statuses(ctx context.Context, now time.Time) ([]Status, error) {
+	repo := repository.ScheduleRunRepository()
+	statuses := make([]Status, 0, len(s.entries))
+	for _, e := range s.entries {
+		status := Status{Name: e.Name, Cron: e.cron.String(), Types: e.ServiceTypes(), Mode: e.ModeOrDefault(), NextRunAt: e.cron.Next(now)}
+		last, err := repo.FindByName(ctx, e.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s 실행 기록 조회 실패: %w", e.Name, err)
+		}
+		if last != nil {
+			status.LastRunAt = last.LastRunAt
+			status.Missed = missedRuns(e.cron, last.LastRunAt, now, e.CatchUpLimit())
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Run - 놓친 실행을 먼저 처리한 뒤 ctx가 취소될 때까지 일정에 맞춰 실행합니다
+func (s *Scheduler) Run(ctx context.Context) error {
+	statuses, err := s.Statuses(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 꺼져 있던 동안 놓친 실행 처리
+	for i, e := range s.entries {
+		status := statuses[i]
+		if status.LastRunAt.IsZero() {
+			log.Printf("🗓️ %s: 실행 기록 없음, 다음 일정부터 실행합니다", e.Name)
+		}
+		for _, runAt := range status.Missed {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("⏪ %s: 놓친 실행을 처리합니다 (예정 시각 %s)", e.Name, runAt.Format(time.DateTime))
+			s.fire(ctx, e, runAt)
+		}
+	}
+
+	now := time.Now()
+	for _, e := range s.entries {
+		e.next = e.cron.Next(now)
+		log.Printf("🗓️ %s (%s, %s): 다음 실행 %s", e.Name, e.cron, strings.Join(e.ServiceTypes(), ","), e.next.Format(time.DateTime))
+	}
+
+	for {
+		e := s.earliest()
+		if e == nil {
+			return fmt.Errorf("실행 가능한 일정이 없습니다")
+		}
+
+		timer := time.NewTimer(time.Until(e.next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Println("scheduler 종료")
+			return nil
+		case <-timer.C:
+		}
+
+		s.fire(ctx, e, e.next)
+		// 실행이 오래 걸려 지나간 일정은 건너뛰고, 다음 시작 시 catch_up으로 처리
+		e.next = e.cron.Next(time.Now())
+		log.Printf("🗓️ %s: 다음 실행 %s", e.Name, e.next.Format(time.DateTime))
+	}
+}
+
+// earliest - 다음 실행 시각이 가장 빠른 항목 (일정이 없는 항목은 제외)
+func (s *Scheduler) earliest() *entry {
+	var found *entry
+	for _, e := range s.entries {
+		if e.next.IsZero() {
+			continue
+		}
+		if found == nil || e.next.Before(found.next) {
+			found = e
+		}
+	}
+	return found
+}
+
+// fire - runAt 예정분을 실행하고, 모든 작업이 성공하면 마지막 성공 시각을 기록합니다
+func (s *Scheduler) fire(ctx context.Context, e *entry, runAt time.Time) {
+	targetDate := e.TargetDate(runAt)
+	jobs := make([]dto.BatchJob, 0, len(e.ServiceTypes()))
+	for _, serviceType := range e.ServiceTypes() {
+		jobs = append(jobs, dto.BatchJob{ServiceType: serviceType, Date: targetDate})
+	}
+
+	var err error
+	if e.ModeOrDefault() == config.ScheduleModeEnqueue {
+		var ids []int64
+		ids, err = worker.Enqueue(ctx, jobs, nil)
+		if err == nil {
+			log.Printf("📥 %s: %d건 대기열 등록 (날짜=%s, ID=%v)", e.Name, len(ids), targetDate, ids)
+		}
+	} else {
+		err = s.runJobs(ctx, jobs)
+	}
+
+	if err != nil {
+		log.Printf("❌ %s: 실행 실패 (예정 시각 %s): %v", e.Name, runAt.Format(time.DateTime), err)
+		return
+	}
+
+	// 종료 요청을 받은 뒤에도 기록은 남김
+	if err := repository.ScheduleRunRepository().RecordSuccess(context.Background(), e.Name, runAt, targetDate); err != nil {
+		log.Printf("%s: 실행 기록 저장 실패: %v", e.Name, err)
+	}
+}
+
+// runJobs - 작업을 순서대로 실행합니다. 실패한 작업이 있어도 나머지는 실행하고 첫 번째 에러를 반환합니다.
+// 이미 생성된 영상이 있는 작업은 건너뛰므로, 놓친 실행 처리나 같은 날 재시작으로 기존 영상을 덮어쓰지 않습니다.
+func (s *Scheduler) runJobs(ctx context.Context, jobs []dto.BatchJob) error {
+	var firstErr error
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return fmt.Errorf("실행 취소됨: %w", ctx.Err())
+		}
+
+		if run := s.videoFactory.AlreadyProduced(ctx, job.Date, job.ServiceType); run != nil {
+			log.Printf("⏭️ 이미 생성됨: 타입=%s, 날짜=%s, 파일=%s (다시 생성하려면 generate --force)", job.ServiceType, job.Date, run.OutputFile)
+			continue
+		}
+		log.Printf("📹 영상 생성 시작: 타입=%s, 날짜=%s", job.ServiceType, job.Date)
+		jobCtx, cancel := context.WithTimeout(ctx, config.JobTimeout())
+		_, err := s.videoFactory.CreateVideo(jobCtx, job.Date, job.ServiceType)
+		cancel()
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("타입=%s, 날짜=%s: %w", job.ServiceType, job.Date, err)
+		}
+	}
+	return firstErr
+}
+
+// missedRuns - lastRunAt 이후 now까지 지나간 실행 시각 중 최근 limit개 (오래된 순)
+func missedRuns(cron *Cron, lastRunAt time.Time, now time.Time, limit int) []time.Time {
+	if limit <= 0 {
+		return nil
+	}
+	missed := make([]time.Time, 0)
+	for t := cron.Next(lastRunAt); !t.IsZero() && !t.After(now); t = cron.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > limit {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
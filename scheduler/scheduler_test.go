@@ -0,0 +1,33 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMissedRunsKeepsMostRecent(t *testing.T) {
+	cron, err := ParseCron("0 5 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastRunAt := time.Date(2026, 10, 13, 5, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 17, 6, 0, 0, 0, time.UTC)
+
+	missed := missedRuns(cron, lastRunAt, now, 2)
+	want := []time.Time{
+		time.Date(2026, 10, 16, 5, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 17, 5, 0, 0, 0, time.UTC),
+	}
+	if len(missed) != len(want) {
+		t.Fatalf("missedRuns = %v, want %v", missed, want)
+	}
+	for i := range want {
+		if !missed[i].Equal(want[i]) {
+			t.Errorf("missedRuns[%d] = %v, want %v", i, missed[i], want[i])
+		}
+	}
+
+	if got := missedRuns(cron, now, now, 2); len(got) != 0 {
+		t.Errorf("missedRuns after last run = %v, want none", got)
+	}
+}
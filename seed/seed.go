@@ -0,0 +1,37 @@
+package seed
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"text/template"
+	"time"
+
+	"auto-video-service/contentpack"
+)
+
+// sampleWeekYAML - 단어/숙어/문장 숏폼, 유튜브 숏폼, 롱폼을 모두 만들 수 있는 7일치 샘플 콘텐츠
+//
+//go:embed sample-week.yaml
+var sampleWeekYAML string
+
+// SampleDays - 샘플 콘텐츠의 날짜 수
+const SampleDays = 7
+
+// SampleWeek - start부터 7일 동안의 샘플 콘텐츠 팩
+func SampleWeek(start time.Time) (*contentpack.Pack, error) {
+	tmpl, err := template.New("sample-week").Funcs(template.FuncMap{
+		"day": func(offset int) string {
+			return start.AddDate(0, 0, offset).Format("20060102")
+		},
+	}).Parse(sampleWeekYAML)
+	if err != nil {
+		return nil, fmt.Errorf("샘플 콘텐츠 템플릿 오류: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, fmt.Errorf("샘플 콘텐츠 템플릿 오류: %w", err)
+	}
+	return contentpack.Parse("sample-week.yaml", buf.Bytes())
+}
@@ -0,0 +1,57 @@
+package seed
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSampleWeekCoversEveryDay(t *testing.T) {
+	start := time.Date(2026, 12, 29, 0, 0, 0, 0, time.Local)
+	pack, err := SampleWeek(start)
+	if err != nil {
+		t.Fatalf("SampleWeek: %v", err)
+	}
+	if removed := pack.Dedupe(); removed.Total() != 0 {
+		t.Fatalf("sample week has duplicates: %s", removed)
+	}
+
+	dates := pack.Dates()
+	if len(dates) != SampleDays || dates[0] != "20261229" || dates[SampleDays-1] != "20270104" {
+		t.Fatalf("dates = %v", dates)
+	}
+
+	// 날짜마다 숏폼(단어/숙어/문장), 유튜브 숏폼(content_type별), 롱폼(타이틀)을 만들 수 있어야 함
+	for _, date := range dates {
+		kinds := map[string]int{}
+		for _, w := range pack.Words {
+			if w.CreatedDate == date {
+				kinds["word"]++
+			}
+		}
+		for _, i := range pack.Idioms {
+			if i.CreatedDate == date {
+				kinds["idiom"]++
+			}
+		}
+		for _, s := range pack.Sentences {
+			if s.CreatedDate == date {
+				kinds["sentence"]++
+			}
+		}
+		for _, lw := range pack.LongformWords {
+			if lw.ShortsDate == date {
+				kinds["shorts_"+lw.ContentType]++
+			}
+		}
+		for _, title := range pack.Titles {
+			if title.CreatedDate == date {
+				kinds["title"]++
+			}
+		}
+		for _, kind := range []string{"word", "idiom", "sentence", "shorts_word", "shorts_idiom", "shorts_sentence", "title"} {
+			if kinds[kind] == 0 {
+				t.Errorf("%s: no %s content", date, kind)
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"auto-video-service/factory"
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// jobQueueSize - 대기열에 쌓아둘 수 있는 최대 작업 수
+	jobQueueSize = 100
+	// finishedJobLimit - 메모리에 보관하는 완료/실패 작업 수. 넘으면 오래된 작업부터 지웁니다 (조회 시 404)
+	finishedJobLimit = 500
+)
+
+// jobStore - API로 등록된 작업을 메모리에 보관하고 하나씩 실행합니다
+// 렌더링은 작업 내부에서 이미 항목별로 병렬 처리되므로 작업 자체는 순서대로 실행합니다.
+type jobStore struct {
+	mu     sync.Mutex
+	jobs   map[int64]*dto.GenerationJob
+	nextID int64
+	queue  chan int64
+
+	videoFactory *factory.VideoServiceFactory
+}
+
+func newJobStore(videoFactory *factory.VideoServiceFactory) *jobStore {
+	return &jobStore{
+		jobs:         make(map[int64]*dto.GenerationJob),
+		queue:        make(chan int64, jobQueueSize),
+		videoFactory: videoFactory,
+	}
+}
+
+// enqueue - 작업을 등록합니다. 대기열이 가득 차면 false를 반환합니다.
+func (s *jobStore) enqueue(request dto.GenerationJobRequest) (dto.GenerationJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	job := &dto.GenerationJob{
+		ID:        s.nextID,
+		Type:      request.Type,
+		Date:      request.Date,
+		Options:   request.Options,
+		Force:     request.Force,
+		Status:    enum.JobQueued,
+		CreatedAt: time.Now(),
+	}
+
+	select {
+	case s.queue <- job.ID:
+	default:
+		s.nextID--
+		return dto.GenerationJob{}, false
+	}
+	s.jobs[job.ID] = job
+	return *job, true
+}
+
+// get - 작업 상태 조회
+func (s *jobStore) get(id int64) (dto.GenerationJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return dto.GenerationJob{}, false
+	}
+	return *job, true
+}
+
+// list - 등록된 작업 목록 (최신순, status가 비어있지 않으면 해당 상태만)
+func (s *jobStore) list(status enum.JobStatus) []dto.GenerationJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]dto.GenerationJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		if status == "" || job.Status == status {
+			jobs = append(jobs, *job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID > jobs[j].ID })
+	return jobs
+}
+
+func (s *jobStore) update(id int64, apply func(job *dto.GenerationJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.jobs[id]; ok {
+		apply(job)
+	}
+}
+
+// finish - 작업을 완료/실패 상태로 바꾸고, 보관 한도를 넘은 오래된 완료 작업을 지웁니다
+func (s *jobStore) finish(id int64, apply func(job *dto.GenerationJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.jobs[id]; ok {
+		apply(job)
+	}
+
+	finished := make([]int64, 0, len(s.jobs))
+	for jobID, job := range s.jobs {
+		if job.Status == enum.JobDone || job.Status == enum.JobFailed {
+			finished = append(finished, jobID)
+		}
+	}
+	if len(finished) <= finishedJobLimit {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i] < finished[j] })
+	for _, jobID := range finished[:len(finished)-finishedJobLimit] {
+		delete(s.jobs, jobID)
+	}
+}
+
+// run - ctx가 취소될 때까지 대기열의 작업을 하나씩 실행합니다
+func (s *jobStore) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-s.queue:
+			s.execute(ctx, id)
+		}
+	}
+}
+
+func (s *jobStore) execute(ctx context.Context, id int64) {
+	job, ok := s.get(id)
+	if !ok {
+		return
+	}
+
+	startedAt := time.Now()
+	s.update(id, func(job *dto.GenerationJob) {
+		job.Status = enum.JobRunning
+		job.StartedAt = &startedAt
+	})
+
+	// generate와 같이 이미 생성된 영상이 있으면 건너뜀 (force면 다시 생성)
+	if !job.Force {
+		if run := s.videoFactory.AlreadyProduced(ctx, job.Date, job.Type); run != nil {
+			log.Printf("⏭️ API 작업 #%d 건너뜀: 이미 생성됨 (%s)", id, run.OutputFile)
+			finishedAt := time.Now()
+			s.finish(id, func(job *dto.GenerationJob) {
+				job.Status = enum.JobDone
+				job.Skipped = true
+				job.Output = run.OutputFile
+				job.FinishedAt = &finishedAt
+			})
+			return
+		}
+	}
+	log.Printf("📹 API 작업 #%d 시작: 타입=%s, 날짜=%s", id, job.Type, job.Date)
+
+	var overrides dto.OptionOverrides
+	if job.Options != nil {
+		overrides = *job.Options
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, config.JobTimeout())
+	response, err := s.videoFactory.CreateVideoWithOverrides(jobCtx, job.Date, job.Type, overrides)
+	cancel()
+
+	finishedAt := time.Now()
+	s.finish(id, func(job *dto.GenerationJob) {
+		job.FinishedAt = &finishedAt
+		if err != nil {
+			job.Status = enum.JobFailed
+			job.Error = err.Error()
+			return
+		}
+		job.Status = enum.JobDone
+		job.Output = response.FinalFileName
+	})
+
+	if err != nil {
+		log.Printf("❌ API 작업 #%d 실패: %v", id, err)
+		return
+	}
+	log.Printf("✅ API 작업 #%d 완료: %s", id, response.FinalFileName)
+}
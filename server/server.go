@@ -0,0 +1,256 @@
+package server
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"auto-video-service/factory"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Server - 영상 생성 작업을 등록/조회하고 결과 영상을 내려받는 REST API 서버
+type Server struct {
+	jobs *jobStore
+}
+
+func NewServer(videoFactory *factory.VideoServiceFactory) *Server {
+	return &Server{jobs: newJobStore(videoFactory)}
+}
+
+// Handler - API 라우팅
+//
+//	GET  /service-types       등록된 서비스 타입 목록
+//	POST /jobs                작업 등록 {"type":"iw","date":"20260101","options":{...}}
+//	GET  /jobs[?status=done]  작업 목록
+//	GET  /jobs/{id}           작업 상태
+//	GET  /outputs             생성 완료된 영상 목록
+//	GET  /outputs/{name}      영상 다운로드
+//
+// config.json의 ApiToken이 있으면 모든 요청에 "Authorization: Bearer <ApiToken>"이 필요합니다.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /service-types", s.handleServiceTypes)
+	mux.HandleFunc("POST /jobs", s.handleCreateJob)
+	mux.HandleFunc("GET /jobs", s.handleListJobs)
+	mux.HandleFunc("GET /jobs/{id}", s.handleGetJob)
+	mux.HandleFunc("GET /outputs", s.handleListOutputs)
+	mux.HandleFunc("GET /outputs/{name}", s.handleDownloadOutput)
+	if config.Config.ApiToken == "" {
+		return mux
+	}
+	return requireToken(config.Config.ApiToken, mux)
+}
+
+// requireToken - Authorization 헤더의 Bearer 토큰이 token과 같을 때만 next로 넘깁니다
+func requireToken(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("인증 토큰이 없거나 올바르지 않습니다"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsLoopbackAddr - 수신 주소(host:port)가 이 컴퓨터에서만 접근 가능한지 여부 (host가 비어 있으면 모든 인터페이스)
+func IsLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil || host == "" {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ListenAndServe - ctx가 취소될 때까지 API 서버와 작업 실행기를 실행합니다
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go s.jobs.run(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Printf("🌐 API 서버 시작: %s (Environment=%s)", addr, config.Config.Environment)
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		log.Println("API 서버를 종료합니다...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+func (s *Server) handleServiceTypes(w http.ResponseWriter, r *http.Request) {
+	type serviceType struct {
+		Code        string `json:"code"`
+		Description string `json:"description"`
+		Platform    string `json:"platform"`
+		Orientation string `json:"orientation"`
+		ContentType string `json:"content_type,omitempty"`
+	}
+	types := make([]serviceType, 0)
+	for _, spec := range factory.Specs() {
+		types = append(types, serviceType{
+			Code:        string(spec.Code),
+			Description: spec.Description,
+			Platform:    string(spec.Platform),
+			Orientation: string(spec.Orientation),
+			ContentType: string(spec.ContentType),
+		})
+	}
+	writeJSON(w, http.StatusOK, types)
+}
+
+func (s *Server) handleCreateJob(w http.ResponseWriter, r *http.Request) {
+	var request dto.GenerationJobRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("요청 본문이 올바르지 않습니다: %w", err))
+		return
+	}
+	if err := validateJobRequest(&request, time.Now()); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	job, ok := s.jobs.enqueue(request)
+	if !ok {
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("대기 중인 작업이 너무 많습니다 (최대 %d건)", jobQueueSize))
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/jobs/%d", job.ID))
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.jobs.list(enum.JobStatus(r.URL.Query().Get("status"))))
+}
+
+func (s *Server) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("작업 ID가 올바르지 않습니다: %s", r.PathValue("id")))
+		return
+	}
+	job, ok := s.jobs.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("작업을 찾을 수 없습니다: %d", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *Server) handleListOutputs(w http.ResponseWriter, r *http.Request) {
+	entries, err := os.ReadDir(factory.FinalVideoDir())
+	if err != nil && !os.IsNotExist(err) {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	outputs := make([]dto.OutputFile, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".mp4" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		outputs = append(outputs, dto.OutputFile{
+			Name:       entry.Name(),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+			URL:        "/outputs/" + entry.Name(),
+		})
+	}
+	sort.Slice(outputs, func(i, j int) bool { return outputs[i].ModifiedAt.After(outputs[j].ModifiedAt) })
+	writeJSON(w, http.StatusOK, outputs)
+}
+
+func (s *Server) handleDownloadOutput(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	// final-video 디렉토리 밖의 파일에 접근하지 못하도록 파일명만 허용
+	if name != filepath.Base(name) || strings.HasPrefix(name, ".") || filepath.Ext(name) != ".mp4" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("파일명이 올바르지 않습니다: %s", name))
+		return
+	}
+	path := filepath.Join(factory.FinalVideoDir(), name)
+	if _, err := os.Stat(path); err != nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("영상을 찾을 수 없습니다: %s", name))
+		return
+	}
+	w.Header().Set("Content-Type", "video/mp4")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	http.ServeFile(w, r, path)
+}
+
+// validateJobRequest - 서비스 타입, 날짜, 옵션 값을 검사하고 날짜를 YYYYMMDD로 정규화합니다
+func validateJobRequest(request *dto.GenerationJobRequest, now time.Time) error {
+	if _, ok := factory.Lookup(request.Type); !ok {
+		return fmt.Errorf("type 값이 올바르지 않습니다 (입력값: %s). 허용된 타입: %s", request.Type, strings.Join(factory.Codes(), ", "))
+	}
+
+	request.Date = config.ResolveDate(request.Date, now)
+	if _, err := time.Parse("20060102", request.Date); err != nil {
+		return fmt.Errorf("날짜 형식이 잘못되었습니다. YYYYMMDD 형식으로 입력해주세요. (입력값: %s)", request.Date)
+	}
+
+	if options := request.Options; options != nil {
+		if options.EnglishRepeatCount != nil && *options.EnglishRepeatCount < 1 {
+			return fmt.Errorf("english_repeat_count는 1 이상이어야 합니다")
+		}
+		if options.SpeakSpeed != nil && *options.SpeakSpeed <= 0 {
+			return fmt.Errorf("speak_speed는 0보다 커야 합니다")
+		}
+		if options.PauseDuration != nil && *options.PauseDuration < 0 {
+			return fmt.Errorf("pause_duration은 음수일 수 없습니다")
+		}
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("응답 작성 실패: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
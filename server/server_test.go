@@ -0,0 +1,117 @@
+package server
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"auto-video-service/factory"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateAndGetJob(t *testing.T) {
+	handler := NewServer(factory.NewVideoServiceFactory()).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(`{"type":"yl","date":"20260101"}`)))
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("Expected 202, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var created dto.GenerationJob
+	if err := json.NewDecoder(rec.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if created.Status != enum.JobQueued || created.Date != "20260101" {
+		t.Errorf("Unexpected job: %+v", created)
+	}
+
+	location := rec.Header().Get("Location")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, location, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 for %s, got %d: %s", location, rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/999", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown job, got %d", rec.Code)
+	}
+}
+
+func TestCreateJobRejectsInvalidRequests(t *testing.T) {
+	handler := NewServer(factory.NewVideoServiceFactory()).Handler()
+
+	for _, body := range []string{
+		`{"type":"unknown","date":"20260101"}`,
+		`{"type":"yl","date":"2026-01-01"}`,
+		`{"type":"yl","date":"20260101","options":{"speak_speed":0}}`,
+		`{"type":"yl","date":"20260101","extra":true}`,
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(body)))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %s, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestDownloadRejectsPathTraversal(t *testing.T) {
+	handler := NewServer(factory.NewVideoServiceFactory()).Handler()
+
+	for _, path := range []string{"/outputs/..%2Fconfig.mp4", "/outputs/config.json"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %s, got %d", path, rec.Code)
+		}
+	}
+}
+
+func TestTokenRequiredWhenConfigured(t *testing.T) {
+	config.Config.ApiToken = "secret"
+	defer func() { config.Config.ApiToken = "" }()
+	handler := NewServer(factory.NewVideoServiceFactory()).Handler()
+
+	for header, want := range map[string]int{"": http.StatusUnauthorized, "Bearer wrong": http.StatusUnauthorized, "Bearer secret": http.StatusOK} {
+		req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Errorf("Authorization %q: expected %d, got %d", header, want, rec.Code)
+		}
+	}
+}
+
+func TestFinishedJobsAreEvicted(t *testing.T) {
+	store := newJobStore(factory.NewVideoServiceFactory())
+	for i := 0; i < finishedJobLimit+10; i++ {
+		job, _ := store.enqueue(dto.GenerationJobRequest{Type: "yl", Date: "20260101"})
+		<-store.queue
+		store.finish(job.ID, func(job *dto.GenerationJob) { job.Status = enum.JobDone })
+	}
+
+	if got := len(store.list("")); got != finishedJobLimit {
+		t.Fatalf("Expected %d retained jobs, got %d", finishedJobLimit, got)
+	}
+	if _, ok := store.get(1); ok {
+		t.Error("Expected oldest finished job to be evicted")
+	}
+	if _, ok := store.get(finishedJobLimit + 10); !ok {
+		t.Error("Expected newest finished job to be retained")
+	}
+}
+
+func TestIsLoopbackAddr(t *testing.T) {
+	for addr, want := range map[string]bool{"127.0.0.1:8080": true, "localhost:8080": true, "[::1]:8080": true, ":8080": false, "0.0.0.0:8080": false, "10.0.0.5:8080": false} {
+		if got := IsLoopbackAddr(addr); got != want {
+			t.Errorf("IsLoopbackAddr(%q) = %v, want %v", addr, got, want)
+		}
+	}
+}
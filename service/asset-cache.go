@@ -0,0 +1,260 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 캐시 종류 (캐시 디렉토리의 하위 디렉토리 이름)
+const (
+	cacheKindAudio = "audio"
+	cacheKindImage = "images"
+	cacheKindClip  = "clips"
+)
+
+// AssetCache - 입력값 해시로 찾는 음성/이미지/클립 캐시
+// 같은 텍스트·템플릿·인코더 설정으로 만든 파일은 다시 생성하지 않고 복사해서 사용합니다.
+// 캐시 디렉토리가 비어있으면(캐시 비활성화) 모든 메서드가 아무 동작도 하지 않습니다.
+type AssetCache struct {
+	dir string
+}
+
+var (
+	assetCacheOnce     sync.Once
+	assetCacheInstance *AssetCache
+)
+
+// NewAssetCache - 지정한 디렉토리를 사용하는 캐시를 만듭니다 (dir이 비어있으면 비활성화)
+func NewAssetCache(dir string) *AssetCache {
+	return &AssetCache{dir: dir}
+}
+
+// DefaultAssetCache - config.json의 Paths.CacheDir를 사용하는 캐시 (싱글톤)
+func DefaultAssetCache() *AssetCache {
+	assetCacheOnce.Do(func() {
+		assetCacheInstance = NewAssetCache(config.CacheDir())
+	})
+	return assetCacheInstance
+}
+
+// Enabled - 캐시 사용 여부
+func (c *AssetCache) Enabled() bool {
+	return c != nil && c.dir != ""
+}
+
+// Dir - 캐시 디렉토리
+func (c *AssetCache) Dir() string {
+	return c.dir
+}
+
+// CacheKey - 입력값들로 캐시 키(sha256)를 만듭니다
+func CacheKey(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		io.WriteString(h, part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// FileChecksum - 파일 내용의 sha256 (hex), 생성 이력에 최종 영상 checksum으로 기록
+func FileChecksum(path string) (string, error) {
+	return fileDigest(path)
+}
+
+// fileDigest - 파일 내용의 sha256 (템플릿/폰트/입력 미디어가 바뀌면 키도 바뀌도록)
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// path - 캐시 파일 경로 (<dir>/<kind>/<key 앞 2자리>/<key><ext>)
+func (c *AssetCache) path(kind, key, ext string) string {
+	return filepath.Join(c.dir, kind, key[:2], key+ext)
+}
+
+// Restore - 캐시에 있으면 dest로 복사하고 true를 반환합니다
+func (c *AssetCache) Restore(kind, key, dest string) bool {
+	if !c.Enabled() || key == "" {
+		return false
+	}
+	cached := c.path(kind, key, filepath.Ext(dest))
+	if _, err := os.Stat(cached); err != nil {
+		return false
+	}
+	if err := copyFile(cached, dest); err != nil {
+		log.Printf("캐시 복사 실패 (%s): %v", cached, err)
+		return false
+	}
+	// 마지막 사용 시각 갱신 (prune 시 오래 사용하지 않은 파일부터 삭제)
+	now := time.Now()
+	os.Chtimes(cached, now, now)
+	return true
+}
+
+// Store - 생성한 파일을 캐시에 저장합니다. 캐시 저장 실패는 작업을 실패시키지 않습니다.
+func (c *AssetCache) Store(kind, key, src string) {
+	if !c.Enabled() || key == "" {
+		return
+	}
+	cached := c.path(kind, key, filepath.Ext(src))
+	if err := os.MkdirAll(filepath.Dir(cached), 0755); err != nil {
+		log.Printf("캐시 디렉토리 생성 실패: %v", err)
+		return
+	}
+
+	// 다른 작업이 같은 키를 동시에 쓰더라도 깨진 파일이 보이지 않도록 임시 파일에 쓴 뒤 이름 변경
+	tmp, err := os.CreateTemp(filepath.Dir(cached), "store-*")
+	if err != nil {
+		log.Printf("캐시 저장 실패: %v", err)
+		return
+	}
+	tmpPath := tmp.Name()
+	tmp.Close()
+	defer os.Remove(tmpPath)
+
+	if err := copyFile(src, tmpPath); err != nil {
+		log.Printf("캐시 저장 실패 (%s): %v", src, err)
+		return
+	}
+	if err := os.Rename(tmpPath, cached); err != nil {
+		log.Printf("캐시 저장 실패 (%s): %v", src, err)
+	}
+}
+
+// CacheStats - 캐시 사용량
+type CacheStats struct {
+	Files int
+	Bytes int64
+}
+
+// PruneResult - cache prune 결과
+type PruneResult struct {
+	Before  CacheStats
+	After   CacheStats
+	Removed int
+}
+
+type cacheEntry struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (c *AssetCache) entries() ([]cacheEntry, error) {
+	entries := make([]cacheEntry, 0)
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == c.dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, cacheEntry{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return entries, err
+}
+
+// Stats - 캐시 파일 수와 전체 용량을 계산합니다
+func (c *AssetCache) Stats() (CacheStats, error) {
+	if !c.Enabled() {
+		return CacheStats{}, nil
+	}
+	entries, err := c.entries()
+	if err != nil {
+		return CacheStats{}, fmt.Errorf("캐시 조회 실패: %w", err)
+	}
+	return statsOf(entries), nil
+}
+
+// Prune - maxAge보다 오래 사용하지 않은 파일을 삭제하고, 남은 용량이 maxBytes를 넘으면
+// 오래 사용하지 않은 파일부터 삭제합니다 (maxAge가 0이면 기간 제한 없음)
+func (c *AssetCache) Prune(maxBytes int64, maxAge time.Duration, now time.Time) (PruneResult, error) {
+	var result PruneResult
+	if !c.Enabled() {
+		return result, nil
+	}
+
+	entries, err := c.entries()
+	if err != nil {
+		return result, fmt.Errorf("캐시 조회 실패: %w", err)
+	}
+	result.Before = statsOf(entries)
+
+	// 오래된 순서로 정렬
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+
+	total := result.Before.Bytes
+	kept := make([]cacheEntry, 0, len(entries))
+	for _, entry := range entries {
+		expired := maxAge > 0 && now.Sub(entry.modTime) > maxAge
+		overSize := total > maxBytes
+		if !expired && !overSize {
+			kept = append(kept, entry)
+			continue
+		}
+		if err := os.Remove(entry.path); err != nil {
+			return result, fmt.Errorf("캐시 파일 삭제 실패: %w", err)
+		}
+		total -= entry.size
+		result.Removed++
+	}
+	result.After = statsOf(kept)
+	return result, nil
+}
+
+func statsOf(entries []cacheEntry) CacheStats {
+	stats := CacheStats{Files: len(entries)}
+	for _, entry := range entries {
+		stats.Bytes += entry.size
+	}
+	return stats
+}
+
+// copyFile - src 파일을 dest로 복사합니다
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAssetCacheStoreAndRestore(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewAssetCache(filepath.Join(dir, "cache"))
+
+	src := filepath.Join(dir, "kor_0.mp3")
+	if err := os.WriteFile(src, []byte("audio"), 0644); err != nil {
+		t.Fatalf("Failed to write source: %v", err)
+	}
+
+	key := CacheKey("say", "Yuna", "175", "안녕하세요")
+	dest := filepath.Join(dir, "restored.mp3")
+	if cache.Restore(cacheKindAudio, key, dest) {
+		t.Fatal("Expected cache miss before Store")
+	}
+
+	cache.Store(cacheKindAudio, key, src)
+	if !cache.Restore(cacheKindAudio, key, dest) {
+		t.Fatal("Expected cache hit after Store")
+	}
+	data, err := os.ReadFile(dest)
+	if err != nil || string(data) != "audio" {
+		t.Fatalf("Restored content mismatch: %q, %v", data, err)
+	}
+
+	if cache.Restore(cacheKindAudio, CacheKey("say", "Yuna", "150", "안녕하세요"), dest) {
+		t.Error("Expected a different rate to miss the cache")
+	}
+}
+
+func TestAssetCachePruneRemovesLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewAssetCache(dir)
+	now := time.Now()
+
+	write := func(name string, size int, age time.Duration) string {
+		path := filepath.Join(dir, cacheKindClip, "ab", name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	oldest := write("ab1.mp4", 100, 3*time.Hour)
+	middle := write("ab2.mp4", 100, 2*time.Hour)
+	newest := write("ab3.mp4", 100, time.Hour)
+
+	result, err := cache.Prune(150, 0, now)
+	if err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+	if result.Removed != 2 || result.After.Bytes != 100 {
+		t.Errorf("Expected 2 removed and 100 bytes left, got %+v", result)
+	}
+	for _, path := range []string{oldest, middle} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be pruned", path)
+		}
+	}
+	if _, err := os.Stat(newest); err != nil {
+		t.Errorf("Expected newest entry to remain: %v", err)
+	}
+}
@@ -1,76 +1,88 @@
 package service
 
 import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
 )
 
 // AudioService 오디오 생성 서비스
-type AudioService struct{}
+type AudioService struct {
+	workDir string      // 임시 스크립트를 쓰는 작업 디렉토리
+	cache   *AssetCache // 같은 텍스트/음성/속도의 음성 파일 재사용
+}
 
-// NewAudioService 새로운 오디오 서비스 생성
-func NewAudioService() *AudioService {
-	return &AudioService{}
+// NewAudioService 새로운 오디오 서비스 생성 (workDir: 작업별 임시 디렉토리, 비우면 OS 임시 디렉토리)
+func NewAudioService(workDir string) *AudioService {
+	return &AudioService{workDir: workDir, cache: DefaultAssetCache()}
 }
 
 // CreateKoreanAudioWithRate 한국어 텍스트로부터 지정된 속도의 음성을 생성합니다
 func (s *AudioService) CreateKoreanAudioWithRate(
+	ctx context.Context,
 	text string,
 	outputPath string,
 	rate int,
 ) error {
+	voice := "Yuna"
+	cacheKey := CacheKey("say", voice, fmt.Sprintf("%d", rate), text)
+	if s.cache.Restore(cacheKindAudio, cacheKey, outputPath) {
+		return nil
+	}
+
 	// 임시 aiff 파일 경로
 	tempAiffPath := outputPath[:len(outputPath)-4] + ".aiff"
 
 	// macOS의 say 명령어를 사용하여 aiff 음성 생성 (속도 조절)
-	cmd := exec.Command("say",
-		"-v", "Yuna",
+	_, err := runCommand(ctx, config.SayTimeout(), true, "say",
+		"-v", voice,
 		"-r", fmt.Sprintf("%d", rate),
 		"-o", tempAiffPath,
 		text,
 	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("음성 생성 실패: %v", err)
+	if err != nil {
+		return fmt.Errorf("%w: 음성 생성 실패: %w", apperror.ErrTTS, err)
 	}
 
 	// aiff를 mp3로 변환
-	convertCmd := exec.Command("ffmpeg",
+	convertArgs := []string{
 		"-i", tempAiffPath,
 		"-acodec", "libmp3lame",
 		"-ab", "128k",
 		"-y",
 		outputPath,
-	)
-
-	convertCmd.Stdout = os.Stdout
-	convertCmd.Stderr = os.Stderr
+	}
 
-	if err := convertCmd.Run(); err != nil {
-		return fmt.Errorf("mp3 변환 실패: %v", err)
+	if err := runFFmpeg(ctx, convertArgs...); err != nil {
+		return fmt.Errorf("mp3 변환 실패: %w", err)
 	}
 
 	// 임시 aiff 파일 삭제
 	os.Remove(tempAiffPath)
 
+	s.cache.Store(cacheKindAudio, cacheKey, outputPath)
 	return nil
 }
 
 // CreateNativeEnglishAudio 원어민 수준의 영어 음성을 생성합니다
-func (s *AudioService) CreateNativeEnglishAudio(text, outputPath string, isSlow bool) error {
+func (s *AudioService) CreateNativeEnglishAudio(ctx context.Context, text, outputPath string, isSlow bool) error {
 	// slow 옵션 문자열 변환 (Python boolean)
 	slowStr := "False"
 	if isSlow {
 		slowStr = "True"
 	}
+	cacheKey := CacheKey("gtts", "en", "us", slowStr, text)
+	if s.cache.Restore(cacheKindAudio, cacheKey, outputPath) {
+		return nil
+	}
+
 	// Python 스크립트로 고품질 영어 음성 생성
 	scriptContent := fmt.Sprintf(`#!/usr/bin/env python3
 from gtts import gTTS
 import os
+import sys
 
 def generate_native_english_audio(text, output_path):
     try:
@@ -87,23 +99,29 @@ def generate_native_english_audio(text, output_path):
 text = "%s"
 output_file = "%s"
 
-generate_native_english_audio(text, output_file)
+if not generate_native_english_audio(text, output_file):
+    sys.exit(1)
 `, slowStr, text, outputPath)
 
-	// 임시 스크립트 파일 생성
-	scriptFile := "temp_english_audio.py"
-	err := os.WriteFile(scriptFile, []byte(scriptContent), 0644)
+	// 임시 스크립트 파일 생성 (작업 디렉토리 안에 고유한 이름으로 생성)
+	script, err := os.CreateTemp(s.workDir, "english-audio-*.py")
 	if err != nil {
 		return fmt.Errorf("영어 음성 스크립트 파일 생성 실패: %v", err)
 	}
+	scriptFile := script.Name()
 	defer os.Remove(scriptFile)
+	_, err = script.WriteString(scriptContent)
+	script.Close()
+	if err != nil {
+		return fmt.Errorf("영어 음성 스크립트 파일 생성 실패: %v", err)
+	}
 
 	// Python 스크립트 실행
-	cmd := exec.Command("python3", scriptFile)
-	output, err := cmd.CombinedOutput()
+	output, err := runCommand(ctx, config.GTTSTimeout(), false, "python3", scriptFile)
 	if err != nil {
-		return fmt.Errorf("영어 음성 생성 스크립트 실행 실패: %v, 출력: %s", err, string(output))
+		return fmt.Errorf("%w: 영어 음성 생성 스크립트 실행 실패: %w, 출력: %s", apperror.ErrTTS, err, string(output))
 	}
 
+	s.cache.Store(cacheKindAudio, cacheKey, outputPath)
 	return nil
 }
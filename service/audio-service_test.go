@@ -0,0 +1,43 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestTTSCancellationKeepsContextError - say/gTTS 실행 중 취소되면 ErrTTS와 함께 context.Canceled도 판별되어야 함 (종료 코드 130, 재시도 안 함)
+func TestTTSCancellationKeepsContextError(t *testing.T) {
+	// 취소될 때까지 끝나지 않는 say, python3
+	bin := t.TempDir()
+	for _, name := range []string{"say", "python3"} {
+		if err := os.WriteFile(filepath.Join(bin, name), []byte("#!/bin/sh\nexec sleep 10\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	dir := t.TempDir()
+	audio := &AudioService{workDir: dir, cache: NewAssetCache("")}
+	calls := map[string]func(ctx context.Context) error{
+		"say": func(ctx context.Context) error {
+			return audio.CreateKoreanAudioWithRate(ctx, "안녕", filepath.Join(dir, "kor.mp3"), 180)
+		},
+		"gtts": func(ctx context.Context) error {
+			return audio.CreateNativeEnglishAudio(ctx, "hello", filepath.Join(dir, "eng.mp3"), false)
+		},
+	}
+	for name, call := range calls {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		err := call(ctx)
+		cancel()
+		if !errors.Is(err, context.Canceled) || !errors.Is(err, apperror.ErrTTS) {
+			t.Errorf("%s: err = %v, want context.Canceled and ErrTTS", name, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// commandWaitDelay - 프로세스 종료 후 출력 파이프가 닫히기를 기다리는 최대 시간
+const commandWaitDelay = 5 * time.Second
+
+// runCommand - 외부 명령을 ctx와 개별 제한 시간으로 실행합니다.
+// ctx가 취소(Ctrl-C, 작업 제한 시간)되거나 제한 시간이 지나면 자식 프로세스를 종료합니다.
+// stream이 true면 출력을 터미널로 그대로 보내고, false면 출력을 모아서 반환합니다.
+func runCommand(ctx context.Context, timeout time.Duration, stream bool, name string, args ...string) ([]byte, error) {
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, name, args...)
+	cmd.WaitDelay = commandWaitDelay
+
+	var output []byte
+	var err error
+	if stream {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err = cmd.Run()
+	} else {
+		output, err = cmd.CombinedOutput()
+	}
+	if err == nil {
+		return output, nil
+	}
+
+	// 상위 ctx 취소가 원인이면 그대로 전달 (errors.Is(err, context.Canceled) 판별용)
+	if ctx.Err() != nil {
+		return output, fmt.Errorf("%s 실행 중단: %w", name, ctx.Err())
+	}
+	if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		return output, fmt.Errorf("%s 실행 시간 초과 (%s): %w", name, timeout, cmdCtx.Err())
+	}
+	return output, err
+}
+
+// ffmpegErrorTailLines - 실패 시 에러 메시지에 포함할 ffmpeg 출력의 마지막 줄 수
+const ffmpegErrorTailLines = 10
+
+// runFFmpeg - ffmpeg를 실행하고 실패 시 ErrFFmpeg로 감싸서 반환합니다
+// 진행 상황은 progress 이벤트로 보고하므로 ffmpeg 출력은 터미널로 보내지 않고, 실패했을 때만 마지막 몇 줄을 에러에 포함합니다.
+func runFFmpeg(ctx context.Context, args ...string) error {
+	output, err := runCommand(ctx, config.FFmpegTimeout(), false, "ffmpeg", append([]string{"-hide_banner", "-nostats"}, args...)...)
+	if err != nil {
+		if tail := outputTail(output, ffmpegErrorTailLines); tail != "" {
+			return fmt.Errorf("%w: %w\n%s", apperror.ErrFFmpeg, err, tail)
+		}
+		return fmt.Errorf("%w: %w", apperror.ErrFFmpeg, err)
+	}
+	return nil
+}
+
+// MediaDuration - ffprobe로 영상/음성 파일의 재생 시간(초)을 조회합니다
+func MediaDuration(ctx context.Context, path string) (float64, error) {
+	output, err := runCommand(ctx, config.FFmpegTimeout(), false, "ffprobe",
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		path,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%w: ffprobe 실패: %w", apperror.ErrFFmpeg, err)
+	}
+	duration, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("재생 시간 해석 실패 (%s): %w", path, err)
+	}
+	return duration, nil
+}
+
+// outputTail - 명령 출력의 마지막 n줄
+func outputTail(output []byte, n int) string {
+	lines := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -1,20 +1,31 @@
 package service
 
 import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/contentpack"
 	"auto-video-service/dto"
+	"auto-video-service/duplicate"
 	"auto-video-service/entity"
 	"auto-video-service/enum"
-	"auto-video-service/repository"
 	"context"
 	"fmt"
 	"log"
 	"time"
 )
 
-type ContentDataService struct{}
+type ContentDataService struct {
+	source ContentSource
+}
 
+// NewContentDataService - config.json의 Content.Source(DB 또는 콘텐츠 팩 파일)에서 조회하는 서비스
 func NewContentDataService() *ContentDataService {
-	return &ContentDataService{}
+	return NewContentDataServiceWithSource(DefaultContentSource())
+}
+
+// NewContentDataServiceWithSource - 지정한 콘텐츠 출처에서 조회하는 서비스
+func NewContentDataServiceWithSource(source ContentSource) *ContentDataService {
+	return &ContentDataService{source: source}
 }
 
 func (s *ContentDataService) GetShortsContentByContentType(ctx context.Context, targetDate time.Time, contentType enum.ContentType) (*dto.ContentDataResult, error) {
@@ -33,66 +44,74 @@ func (s *ContentDataService) GetShortsContentByContentType(ctx context.Context,
 }
 
 func (s *ContentDataService) getWordByDate(ctx context.Context, dateStr string) (*dto.ContentDataResult, error) {
-	repo := repository.EnglishWordRepository()
-	words, err := repo.FindByDate(ctx, dateStr)
+	words, err := s.source.Words(ctx, dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("단어 조회 실패: %w", err)
 	}
 	if len(words) == 0 {
-		return nil, fmt.Errorf("%s에 생성된 영어단어가 없습니다", dateStr)
+		return nil, fmt.Errorf("%w: %s에 생성된 영어단어가 없습니다", apperror.ErrNoContent, dateStr)
 	}
+	orderContent(words, dateStr, contentpack.KindWords)
 
 	result := &dto.ContentDataResult{
 		Primary:   make([]string, 0, len(words)),
 		Secondary: make([]string, 0, len(words)),
 		Tertiary:  make([]string, 0, len(words)),
+		Phonetic:  make([]string, 0, len(words)),
 	}
 
 	for _, word := range words {
+		result.Ids = append(result.Ids, word.Id)
 		result.Primary = append(result.Primary, word.EnglishWord)
 		result.Secondary = append(result.Secondary, word.Meaning)
 		result.Tertiary = append(result.Tertiary, word.PronunciationKr)
+		result.Phonetic = append(result.Phonetic, word.PhoneticSymbol)
 	}
 
 	log.Printf("숏폼 DB에서 %s 날짜의 %d개 단어를 조회했습니다.", dateStr, len(words))
+	s.WarnDuplicates(ctx, contentpack.KindWords, dateStr)
 	return result, nil
 }
 
 func (s *ContentDataService) getIdiomByDate(ctx context.Context, dateStr string) (*dto.ContentDataResult, error) {
-	repo := repository.EnglishIdiomRepository()
-	idioms, err := repo.FindByDate(ctx, dateStr)
+	idioms, err := s.source.Idioms(ctx, dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("숙어 조회 실패: %w", err)
 	}
 	if len(idioms) == 0 {
-		return nil, fmt.Errorf("%s에 생성된 영어숙어가 없습니다", dateStr)
+		return nil, fmt.Errorf("%w: %s에 생성된 영어숙어가 없습니다", apperror.ErrNoContent, dateStr)
 	}
+	orderContent(idioms, dateStr, contentpack.KindIdioms)
 
 	result := &dto.ContentDataResult{
 		Primary:   make([]string, 0, len(idioms)),
 		Secondary: make([]string, 0, len(idioms)),
 		Tertiary:  make([]string, 0, len(idioms)),
+		Phonetic:  make([]string, 0, len(idioms)),
 	}
 
 	for _, idiom := range idioms {
+		result.Ids = append(result.Ids, idiom.Id)
 		result.Primary = append(result.Primary, idiom.Idiom)
 		result.Secondary = append(result.Secondary, idiom.Meaning)
 		result.Tertiary = append(result.Tertiary, idiom.PronunciationKr)
+		result.Phonetic = append(result.Phonetic, idiom.PhoneticSymbol)
 	}
 
 	log.Printf("숏폼 DB에서 %s 날짜의 %d개 숙어를 조회했습니다.", dateStr, len(idioms))
+	s.WarnDuplicates(ctx, contentpack.KindIdioms, dateStr)
 	return result, nil
 }
 
 func (s *ContentDataService) getSentenceByDate(ctx context.Context, dateStr string) (*dto.ContentDataResult, error) {
-	repo := repository.ShortSentenceRepository()
-	sentences, err := repo.FindByDate(ctx, dateStr)
+	sentences, err := s.source.Sentences(ctx, dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("문장 조회 실패: %w", err)
 	}
 	if len(sentences) == 0 {
-		return nil, fmt.Errorf("%s에 생성된 단문이 없습니다", dateStr)
+		return nil, fmt.Errorf("%w: %s에 생성된 단문이 없습니다", apperror.ErrNoContent, dateStr)
 	}
+	orderContent(sentences, dateStr, contentpack.KindSentences)
 
 	result := &dto.ContentDataResult{
 		Primary:        make([]string, 0, len(sentences)),
@@ -102,54 +121,97 @@ func (s *ContentDataService) getSentenceByDate(ctx context.Context, dateStr stri
 		Tertiary:       make([]string, 0, len(sentences)),
 	}
 
-	for _, s := range sentences {
-		result.Primary = append(result.Primary, s.EnglishSentence1)
-		result.Secondary = append(result.Secondary, s.KoreanSentence1)
-		result.Tertiary = append(result.Tertiary, s.Pronunciation)
+	for _, sentence := range sentences {
+		result.Ids = append(result.Ids, sentence.Id)
+		result.Primary = append(result.Primary, sentence.EnglishSentence1)
+		result.Secondary = append(result.Secondary, sentence.KoreanSentence1)
+		result.Tertiary = append(result.Tertiary, sentence.Pronunciation)
 
-		if s.EnglishSentence2.Valid {
-			result.PrimaryLine2 = append(result.PrimaryLine2, s.EnglishSentence2.String)
+		if sentence.EnglishSentence2.Valid {
+			result.PrimaryLine2 = append(result.PrimaryLine2, sentence.EnglishSentence2.String)
 		} else {
 			result.PrimaryLine2 = append(result.PrimaryLine2, "")
 		}
 
-		if s.KoreanSentence2.Valid {
-			result.SecondaryLine2 = append(result.SecondaryLine2, s.KoreanSentence2.String)
+		if sentence.KoreanSentence2.Valid {
+			result.SecondaryLine2 = append(result.SecondaryLine2, sentence.KoreanSentence2.String)
 		} else {
 			result.SecondaryLine2 = append(result.SecondaryLine2, "")
 		}
 	}
 
 	log.Printf("숏폼 DB에서 %s 날짜의 %d개 문장을 조회했습니다.", dateStr, len(sentences))
+	s.WarnDuplicates(ctx, contentpack.KindSentences, dateStr)
 	return result, nil
 }
 
 func (s *ContentDataService) GetYoutubeShortsContentByDate(ctx context.Context, targetDate time.Time, contentType enum.ContentType) (*dto.ContentDataResult, error) {
 	dateStr := targetDate.Format("20060102")
-	repo := repository.LongformWordRepository()
-
-	longformWords, err := repo.FindByShortsDateAndContentType(ctx, dateStr, string(contentType))
+	longformWords, err := s.source.ShortsLongformWords(ctx, dateStr, string(contentType))
 	if err != nil {
 		return nil, fmt.Errorf("유튜브 숏폼 DB 조회 실패: %w", err)
 	}
 	if len(longformWords) == 0 {
-		return nil, fmt.Errorf("%s에 해당하는 유튜브 숏폼용 %s 데이터가 없습니다", dateStr, contentType)
+		return nil, fmt.Errorf("%w: %s에 해당하는 유튜브 숏폼용 %s 데이터가 없습니다", apperror.ErrNoContent, dateStr, contentType)
 	}
+	orderContent(longformWords, dateStr, contentpack.KindLongformWords)
 
 	result := &dto.ContentDataResult{
 		Primary:   make([]string, 0, len(longformWords)),
 		Secondary: make([]string, 0, len(longformWords)),
 		Tertiary:  make([]string, 0, len(longformWords)),
+		Phonetic:  make([]string, 0, len(longformWords)),
 	}
 
 	for _, word := range longformWords {
+		result.Ids = append(result.Ids, word.Id)
 		result.Primary = append(result.Primary, word.Word)
 		result.Secondary = append(result.Secondary, word.Meaning)
 		result.Tertiary = append(result.Tertiary, word.PronunciationKr)
+		result.Phonetic = append(result.Phonetic, word.PhoneticSymbol)
 	}
 
 	log.Printf("유튜브 숏폼 DB에서 %s 날짜의 %d개 %s를 조회했습니다.", dateStr, len(longformWords), contentType)
+	s.WarnDuplicates(ctx, contentpack.KindLongformWords, dateStr)
 	return result, nil
 }
 
 var _ = entity.LongformWord{}
+
+// FindDuplicates - from ~ to의 단어/숙어/문장/롱폼 단어 중 windowDays 이내의 다른 날짜에 반복된 콘텐츠
+func (s *ContentDataService) FindDuplicates(ctx context.Context, from time.Time, to time.Time, windowDays int) ([]duplicate.Group, error) {
+	pack, err := s.source.Range(ctx, from.Format("20060102"), to.Format("20060102"))
+	if err != nil {
+		return nil, fmt.Errorf("중복 검사용 콘텐츠 조회 실패: %w", err)
+	}
+	return duplicate.Find(duplicate.FromPack(pack), windowDays), nil
+}
+
+// WarnDuplicates - dateStr의 kind 콘텐츠가 앞뒤 Content.DuplicateWindowDays일 안의 다른 날짜에도 있으면 경고만 출력합니다 (생성은 계속)
+func (s *ContentDataService) WarnDuplicates(ctx context.Context, kind contentpack.Kind, dateStr string) {
+	window := config.DuplicateWindowDays()
+	day, err := time.Parse("20060102", dateStr)
+	if window <= 0 || err != nil {
+		return
+	}
+
+	groups, err := s.FindDuplicates(ctx, day.AddDate(0, 0, -window), day.AddDate(0, 0, window), window)
+	if err != nil {
+		log.Printf("⚠️ 중복 검사 실패: %v", err)
+		return
+	}
+	for _, group := range groups {
+		if group.Kind != kind || !group.Has(dateStr) {
+			continue
+		}
+		near := group.Near(dateStr, window)
+		if len(near) == 0 {
+			continue
+		}
+		dates := make([]string, 0, len(near))
+		for _, e := range near {
+			dates = append(dates, e.Date)
+		}
+		log.Printf("⚠️ 중복 콘텐츠 (%s) %q: %d일 이내의 다른 날짜에도 있습니다 %v", kind, near[0].Text, window, dates)
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/contentpack"
+	"auto-video-service/repository"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ContentImportResult - 콘텐츠 팩 가져오기 결과 (종류별 건수)
+type ContentImportResult struct {
+	Loaded     contentpack.Counts // 파일에서 읽은 레코드
+	Duplicates contentpack.Counts // 파일 안에서 중복되어 제외
+	Existing   contentpack.Counts // DB에 이미 있어 제외
+	Imported   contentpack.Counts // 저장한 레코드 (dry-run이면 저장할 레코드)
+}
+
+// ContentImportService - 콘텐츠 팩을 DB에 저장합니다
+type ContentImportService struct {
+	source ContentSource
+}
+
+func NewContentImportService() *ContentImportService {
+	return &ContentImportService{source: NewDBContentSource()}
+}
+
+// Import - 팩 안의 중복과 DB에 이미 있는 레코드(같은 날짜, 같은 단어/숙어/문장, 날짜당 타이틀 하나)를 제외하고 한 트랜잭션으로 저장합니다
+func (s *ContentImportService) Import(ctx context.Context, pack *contentpack.Pack, dryRun bool) (ContentImportResult, error) {
+	result := ContentImportResult{Loaded: pack.Counts()}
+	result.Duplicates = pack.Dedupe()
+
+	existing, err := s.existing(ctx, pack.Dates())
+	if err != nil {
+		return result, err
+	}
+	rows := pack.Without(existing)
+	result.Imported = rows.Counts()
+	result.Existing = pack.Counts().Sub(result.Imported)
+
+	if dryRun || result.Imported.Total() == 0 {
+		return result, nil
+	}
+
+	var beans []interface{}
+	if len(rows.Words) > 0 {
+		beans = append(beans, &rows.Words)
+	}
+	if len(rows.Idioms) > 0 {
+		beans = append(beans, &rows.Idioms)
+	}
+	if len(rows.Sentences) > 0 {
+		beans = append(beans, &rows.Sentences)
+	}
+	if len(rows.LongformWords) > 0 {
+		beans = append(beans, &rows.LongformWords)
+	}
+	if len(rows.Titles) > 0 {
+		beans = append(beans, &rows.Titles)
+	}
+	if _, err := repository.InsertContent(ctx, beans...); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// existing - 팩에 포함된 날짜의 DB 콘텐츠
+func (s *ContentImportService) existing(ctx context.Context, dates []string) (*contentpack.Pack, error) {
+	existing := &contentpack.Pack{}
+	for _, date := range dates {
+		words, err := s.source.Words(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("기존 단어 조회 실패: %w", err)
+		}
+		idioms, err := s.source.Idioms(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("기존 숙어 조회 실패: %w", err)
+		}
+		sentences, err := s.source.Sentences(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("기존 문장 조회 실패: %w", err)
+		}
+		longformWords, err := s.source.LongformWords(ctx, date)
+		if err != nil {
+			return nil, fmt.Errorf("기존 롱폼 단어 조회 실패: %w", err)
+		}
+		title, err := s.source.Title(ctx, date)
+		if err != nil && !errors.Is(err, apperror.ErrNoContent) {
+			return nil, fmt.Errorf("기존 타이틀 조회 실패: %w", err)
+		}
+
+		existing.Words = append(existing.Words, words...)
+		existing.Idioms = append(existing.Idioms, idioms...)
+		existing.Sentences = append(existing.Sentences, sentences...)
+		existing.LongformWords = append(existing.LongformWords, longformWords...)
+		if title != nil {
+			existing.Titles = append(existing.Titles, *title)
+		}
+	}
+	return existing, nil
+}
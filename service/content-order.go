@@ -0,0 +1,24 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/contentpack"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+)
+
+// orderContent - Content.Order가 shuffle이면 (Content.ShuffleSeed, 날짜, 종류)로 정한 시드로 items를 섞습니다
+// 시드가 같으면 항상 같은 순서이므로 다시 렌더링해도 영상이 달라지지 않습니다. 기본값(sort_order)은 조회 순서를 그대로 둡니다.
+func orderContent[T any](items []T, dateStr string, kind contentpack.Kind) {
+	if !config.ContentShuffled() || len(items) < 2 {
+		return
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s/%s", config.Config.Content.ShuffleSeed, dateStr, kind)
+	// math/rand의 NewSource는 Go 버전이 바뀌어도 같은 시드에 같은 수열을 보장
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+	r.Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+}
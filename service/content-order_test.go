@@ -0,0 +1,43 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/contentpack"
+	"slices"
+	"testing"
+)
+
+func TestOrderContentSeededShuffle(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	shuffled := func(date string) []string {
+		out := slices.Clone(items)
+		orderContent(out, date, contentpack.KindWords)
+		return out
+	}
+
+	defer func(order string, seed int64) {
+		config.Config.Content.Order, config.Config.Content.ShuffleSeed = order, seed
+	}(config.Config.Content.Order, config.Config.Content.ShuffleSeed)
+
+	config.Config.Content.Order = ""
+	if got := shuffled("20261017"); !slices.Equal(got, items) {
+		t.Fatalf("default order changed items: %v", got)
+	}
+
+	config.Config.Content.Order, config.Config.Content.ShuffleSeed = config.ContentOrderShuffle, 42
+	first := shuffled("20261017")
+	if !slices.Equal(first, shuffled("20261017")) {
+		t.Errorf("same seed and date produced different orders")
+	}
+	if slices.Equal(first, items) {
+		t.Errorf("shuffle kept the original order: %v", first)
+	}
+	if slices.Equal(first, shuffled("20261018")) {
+		t.Errorf("different dates produced the same order: %v", first)
+	}
+	sorted := slices.Clone(first)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, items) {
+		t.Errorf("shuffle lost items: %v", first)
+	}
+}
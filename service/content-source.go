@@ -0,0 +1,243 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/contentpack"
+	"auto-video-service/duplicate"
+	"auto-video-service/entity"
+	"auto-video-service/repository"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ContentSource - 날짜별 콘텐츠 조회 출처 (DB 또는 콘텐츠 팩 파일)
+// 프로필의 content_source(shorts/youtube_shorts)는 어떤 데이터를 쓸지, ContentSource는 그 데이터를 어디서 읽을지를 정합니다.
+type ContentSource interface {
+	Words(ctx context.Context, dateStr string) ([]entity.EnglishWord, error)
+	Idioms(ctx context.Context, dateStr string) ([]entity.EnglishIdiom, error)
+	Sentences(ctx context.Context, dateStr string) ([]entity.ShortSentence, error)
+	LongformWords(ctx context.Context, dateStr string) ([]entity.LongformWord, error)
+	// ShortsLongformWords - shorts_date + content_type 기준 롱폼 단어 (유튜브 숏폼)
+	ShortsLongformWords(ctx context.Context, shortsDate string, contentType string) ([]entity.LongformWord, error)
+	// Title - 없으면 apperror.ErrNoContent
+	Title(ctx context.Context, dateStr string) (*entity.Title, error)
+	// Range - created_date가 from ~ to(포함)인 단어/숙어/문장/롱폼 단어 (타이틀 제외, 중복 검사용)
+	// 롱폼 단어는 duplicate.LongformDate 기준 (shorts_date가 있으면 shorts_date)
+	Range(ctx context.Context, from string, to string) (*contentpack.Pack, error)
+}
+
+// DefaultContentSource - config.json의 Content.Source에 따른 콘텐츠 출처 (기본값: DB)
+func DefaultContentSource() ContentSource {
+	if config.ContentFromFiles() {
+		return NewFileContentSource(config.ContentPackDir())
+	}
+	return NewDBContentSource()
+}
+
+// dbContentSource - repository 저장소에서 조회
+type dbContentSource struct{}
+
+// NewDBContentSource - DB 콘텐츠 출처
+func NewDBContentSource() ContentSource {
+	return dbContentSource{}
+}
+
+func (dbContentSource) Words(ctx context.Context, dateStr string) ([]entity.EnglishWord, error) {
+	return repository.EnglishWordRepository().FindByDate(ctx, dateStr)
+}
+
+func (dbContentSource) Idioms(ctx context.Context, dateStr string) ([]entity.EnglishIdiom, error) {
+	return repository.EnglishIdiomRepository().FindByDate(ctx, dateStr)
+}
+
+func (dbContentSource) Sentences(ctx context.Context, dateStr string) ([]entity.ShortSentence, error) {
+	return repository.ShortSentenceRepository().FindByDate(ctx, dateStr)
+}
+
+func (dbContentSource) LongformWords(ctx context.Context, dateStr string) ([]entity.LongformWord, error) {
+	return repository.LongformWordRepository().FindByDate(ctx, dateStr)
+}
+
+func (dbContentSource) ShortsLongformWords(ctx context.Context, shortsDate string, contentType string) ([]entity.LongformWord, error) {
+	return repository.LongformWordRepository().FindByShortsDateAndContentType(ctx, shortsDate, contentType)
+}
+
+func (dbContentSource) Title(ctx context.Context, dateStr string) (*entity.Title, error) {
+	return repository.TitleRepository().FindByDate(ctx, dateStr)
+}
+
+func (dbContentSource) Range(ctx context.Context, from string, to string) (*contentpack.Pack, error) {
+	var pack contentpack.Pack
+	var err error
+	if pack.Words, err = repository.EnglishWordRepository().FindByDateRange(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if pack.Idioms, err = repository.EnglishIdiomRepository().FindByDateRange(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if pack.Sentences, err = repository.ShortSentenceRepository().FindByDateRange(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if pack.LongformWords, err = repository.LongformWordRepository().FindByShortsDateRange(ctx, from, to); err != nil {
+		return nil, err
+	}
+	created, err := repository.LongformWordRepository().FindByDateRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, lw := range created {
+		// 유튜브 숏폼으로 배정된 단어는 위에서 shorts_date 기준으로 조회
+		if lw.ShortsDate == "" {
+			pack.LongformWords = append(pack.LongformWords, lw)
+		}
+	}
+	return &pack, nil
+}
+
+// fileContentSource - 콘텐츠 팩 디렉토리(CSV/JSON/YAML)에서 조회. 처음 조회할 때 한 번 읽습니다.
+// 날짜별 항목은 DB와 같이 sort_order 순이며, 같으면 파일에 적힌 순서입니다.
+type fileContentSource struct {
+	dir  string
+	once sync.Once
+	pack *contentpack.Pack
+	err  error
+}
+
+// NewFileContentSource - dir 아래 콘텐츠 팩 파일을 읽는 콘텐츠 출처
+func NewFileContentSource(dir string) ContentSource {
+	return &fileContentSource{dir: dir}
+}
+
+func (s *fileContentSource) load() (*contentpack.Pack, error) {
+	s.once.Do(func() {
+		s.pack, s.err = contentpack.Load(s.dir)
+		if s.err != nil {
+			s.err = fmt.Errorf("콘텐츠 팩 로드 실패 (%s): %w", s.dir, s.err)
+			return
+		}
+		s.pack.Dedupe()
+	})
+	return s.pack, s.err
+}
+
+func (s *fileContentSource) Words(ctx context.Context, dateStr string) ([]entity.EnglishWord, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var words []entity.EnglishWord
+	for _, w := range pack.Words {
+		if w.CreatedDate == dateStr {
+			words = append(words, w)
+		}
+	}
+	sort.SliceStable(words, func(i, j int) bool { return words[i].SortOrder < words[j].SortOrder })
+	return words, nil
+}
+
+func (s *fileContentSource) Idioms(ctx context.Context, dateStr string) ([]entity.EnglishIdiom, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var idioms []entity.EnglishIdiom
+	for _, i := range pack.Idioms {
+		if i.CreatedDate == dateStr {
+			idioms = append(idioms, i)
+		}
+	}
+	sort.SliceStable(idioms, func(i, j int) bool { return idioms[i].SortOrder < idioms[j].SortOrder })
+	return idioms, nil
+}
+
+func (s *fileContentSource) Sentences(ctx context.Context, dateStr string) ([]entity.ShortSentence, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var sentences []entity.ShortSentence
+	for _, sentence := range pack.Sentences {
+		if sentence.CreatedDate == dateStr {
+			sentences = append(sentences, sentence)
+		}
+	}
+	sort.SliceStable(sentences, func(i, j int) bool { return sentences[i].SortOrder < sentences[j].SortOrder })
+	return sentences, nil
+}
+
+func (s *fileContentSource) LongformWords(ctx context.Context, dateStr string) ([]entity.LongformWord, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var words []entity.LongformWord
+	for _, lw := range pack.LongformWords {
+		if lw.CreatedDate == dateStr {
+			words = append(words, lw)
+		}
+	}
+	sort.SliceStable(words, func(i, j int) bool { return words[i].SortOrder < words[j].SortOrder })
+	return words, nil
+}
+
+func (s *fileContentSource) ShortsLongformWords(ctx context.Context, shortsDate string, contentType string) ([]entity.LongformWord, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var words []entity.LongformWord
+	for _, lw := range pack.LongformWords {
+		if lw.ShortsDate == shortsDate && lw.ContentType == contentType {
+			words = append(words, lw)
+		}
+	}
+	sort.SliceStable(words, func(i, j int) bool { return words[i].SortOrder < words[j].SortOrder })
+	return words, nil
+}
+
+func (s *fileContentSource) Title(ctx context.Context, dateStr string) (*entity.Title, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range pack.Titles {
+		if t.CreatedDate == dateStr {
+			title := t
+			return &title, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: 해당 날짜의 타이틀을 찾을 수 없습니다", apperror.ErrNoContent)
+}
+
+func (s *fileContentSource) Range(ctx context.Context, from string, to string) (*contentpack.Pack, error) {
+	pack, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	inRange := func(date string) bool { return date >= from && date <= to }
+	var result contentpack.Pack
+	for _, w := range pack.Words {
+		if inRange(w.CreatedDate) {
+			result.Words = append(result.Words, w)
+		}
+	}
+	for _, i := range pack.Idioms {
+		if inRange(i.CreatedDate) {
+			result.Idioms = append(result.Idioms, i)
+		}
+	}
+	for _, sentence := range pack.Sentences {
+		if inRange(sentence.CreatedDate) {
+			result.Sentences = append(result.Sentences, sentence)
+		}
+	}
+	for _, lw := range pack.LongformWords {
+		if inRange(duplicate.LongformDate(lw)) {
+			result.LongformWords = append(result.LongformWords, lw)
+		}
+	}
+	return &result, nil
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"fmt"
+	"image"
+	_ "image/png"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+)
+
+const (
+	validationMinFontSize   = 20.0 // 렌더러의 최소 폰트 크기 (이보다 작게 줄이지 않음)
+	validationFontSizeStep  = 10.0 // 렌더러의 폰트 크기 감소 단위
+	validationSmallFontSize = 40.0 // 이보다 작게 줄어들면 경고 (읽기 어려움)
+	pronounceMaxFontSize    = 75.0 // 숏폼 발음 줄 최대 폰트 크기 (GenerateBasicImagesWithFontSize)
+)
+
+// textMeasure - 폰트 크기(pt)에서 text가 그려지는 너비(px)
+type textMeasure func(text string, size float64) int
+
+// textCapacity - 템플릿 이미지와 폰트로 측정한 한 줄의 글자 수용 범위
+type textCapacity struct {
+	maxWidth int // 한 줄 최대 너비 (px)
+	measure  textMeasure
+	missing  func(text string) []rune // 폰트에 없는 글자 (nil이면 검사하지 않음)
+}
+
+// newTextCapacity - 템플릿 크기와 역할의 폰트 목록을 읽어 렌더러와 같은 규칙의 수용 범위를 만듭니다
+// widthRatio가 0이면 숏폼 규칙(가로형 80%, 세로형 70%)을 사용합니다.
+func newTextCapacity(templatePath string, role config.FontRole, widthRatio float64) (*textCapacity, error) {
+	templateFile, err := os.Open(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 이미지 파일을 열 수 없습니다: %v", apperror.ErrMissingTemplate, err)
+	}
+	defer templateFile.Close()
+	imageConfig, _, err := image.DecodeConfig(templateFile)
+	if err != nil {
+		return nil, fmt.Errorf("이미지 디코딩 실패 (%s): %v", templatePath, err)
+	}
+
+	fonts, err := loadFontChain(role)
+	if err != nil {
+		return nil, err
+	}
+
+	if widthRatio == 0 {
+		widthRatio = 0.7 // 세로형
+		if imageConfig.Width > imageConfig.Height {
+			widthRatio = 0.8 // 가로형
+		}
+	}
+
+	return &textCapacity{
+		maxWidth: int(float64(imageConfig.Width) * widthRatio),
+		measure: func(text string, size float64) int {
+			face, err := fonts.face(size, font.HintingFull)
+			if err != nil {
+				return 0
+			}
+			defer face.Close()
+			bounds, _ := font.BoundString(face, text)
+			return (bounds.Max.X - bounds.Min.X).Ceil()
+		},
+		missing: fonts.missing,
+	}, nil
+}
+
+// fit - 렌더러처럼 maxSize부터 10pt씩 줄여 가며 한 줄에 들어가는 크기를 찾습니다
+// 최소 크기에서도 넘치면 fits가 false이고 size/width는 마지막으로 측정한 값입니다.
+func (c *textCapacity) fit(text string, maxSize float64) (size float64, width int, fits bool) {
+	size = maxSize
+	for {
+		width = c.measure(text, size)
+		if width <= c.maxWidth {
+			return size, width, true
+		}
+		if size-validationFontSizeStep < validationMinFontSize {
+			return size, width, false
+		}
+		size -= validationFontSizeStep
+	}
+}
+
+// validationLine - 슬라이드에 그려지는 한 줄과 그 값이 나온 컬럼
+type validationLine struct {
+	Field    string  // DB 컬럼 이름 (english_word, pronunciation_kr ...)
+	Text     string  // 컬럼 값
+	Format   string  // 슬라이드에 그리는 형식 (발음은 "( %s )"), 비우면 그대로
+	MaxSize  float64 // 시작 폰트 크기
+	Required bool    // 비어 있으면 오류
+	Pair     string  // 함께 있어야 하는 컬럼 (english_sentence_2 ↔ korean_sentence_2)
+	Missing  string  // 비어 있을 때의 경고 (Required가 아닌 컬럼)
+}
+
+// validationRow - 영상 안 항목 하나
+type validationRow struct {
+	Id    int64
+	Lines []validationLine
+}
+
+// validateRows - 행마다 빈 값, 공백, 제어 문자, 슬라이드 너비, 2번째 줄 짝을 검사합니다
+func validateRows(rows []validationRow, capacity *textCapacity) []dto.ValidationIssue {
+	issues := make([]dto.ValidationIssue, 0)
+	for i, row := range rows {
+		add := func(field, severity, value, format string, args ...any) {
+			issues = append(issues, dto.ValidationIssue{
+				Row:      i + 1,
+				Id:       row.Id,
+				Field:    field,
+				Severity: severity,
+				Message:  fmt.Sprintf(format, args...),
+				Value:    value,
+			})
+		}
+
+		texts := make(map[string]string, len(row.Lines))
+		for _, line := range row.Lines {
+			texts[line.Field] = strings.TrimSpace(line.Text)
+		}
+
+		for _, line := range row.Lines {
+			trimmed := strings.TrimSpace(line.Text)
+			if trimmed == "" {
+				switch {
+				case line.Required && line.Format != "":
+					add(line.Field, dto.ValidationError, line.Text, "비어 있습니다. 슬라이드에 %q만 표시됩니다", fmt.Sprintf(line.Format, ""))
+				case line.Required:
+					add(line.Field, dto.ValidationError, line.Text, "비어 있습니다. 빈 슬라이드가 만들어집니다")
+				case line.Pair != "" && texts[line.Pair] != "":
+					add(line.Field, dto.ValidationWarning, line.Text, "%s만 있고 %s가 비어 있습니다. 두 컬럼을 함께 채우거나 함께 비우세요", line.Pair, line.Field)
+				case line.Missing != "":
+					add(line.Field, dto.ValidationWarning, line.Text, "%s", line.Missing)
+				}
+				continue
+			}
+
+			if strings.ContainsFunc(line.Text, unicode.IsControl) {
+				add(line.Field, dto.ValidationError, line.Text, "줄바꿈/탭 같은 제어 문자가 있습니다. 슬라이드에 □로 표시되므로 제거하세요")
+			}
+			if strings.ContainsAny(line.Text, "\u200b\ufeff") {
+				add(line.Field, dto.ValidationWarning, line.Text, "보이지 않는 문자(U+200B/U+FEFF)가 있습니다. 복사해 온 값이면 지우고 다시 입력하세요")
+			}
+			if trimmed != line.Text {
+				add(line.Field, dto.ValidationWarning, line.Text, "앞뒤에 공백이 있습니다. 글자가 가운데에서 밀려 보입니다")
+			} else if strings.Contains(line.Text, "  ") {
+				add(line.Field, dto.ValidationWarning, line.Text, "공백이 연속으로 들어 있습니다")
+			}
+
+			if capacity == nil || line.MaxSize == 0 {
+				continue
+			}
+			rendered := line.Text
+			if line.Format != "" {
+				rendered = fmt.Sprintf(line.Format, line.Text)
+			}
+			if capacity.missing != nil {
+				if missing := capacity.missing(rendered); len(missing) > 0 {
+					add(line.Field, dto.ValidationWarning, line.Text, "폰트에 없는 글자 %q가 □로 표시됩니다. 글자를 바꾸거나 config.json의 Fonts에 보조 폰트를 지정하세요", string(missing))
+				}
+			}
+			size, width, fits := capacity.fit(rendered, line.MaxSize)
+			switch {
+			case !fits:
+				add(line.Field, dto.ValidationError, line.Text, "%.0fpt에서도 %dpx로 슬라이드 최대 너비 %dpx를 넘습니다. 줄이거나 두 줄로 나누세요", size, width, capacity.maxWidth)
+			case size < validationSmallFontSize && size < line.MaxSize:
+				add(line.Field, dto.ValidationWarning, line.Text, "길어서 %.0fpt까지 작아집니다 (최대 %.0fpt)", size, line.MaxSize)
+			}
+		}
+	}
+	return issues
+}
+
+// enforceValidation - 검증 결과를 로그로 남기고, 오류가 있으면 렌더링을 막습니다
+// Content.AllowInvalid(또는 generate --allow-invalid)이면 오류도 경고로만 출력하고 계속합니다.
+func enforceValidation(report dto.ValidationReport) error {
+	for _, issue := range report.Issues {
+		icon := "⚠️"
+		if issue.Severity == dto.ValidationError {
+			icon = "❌"
+		}
+		log.Printf("%s 콘텐츠 검증 %s %d번 항목(id=%d) %s: %s", icon, report.ServiceType, issue.Row, issue.Id, issue.Field, issue.Message)
+	}
+
+	errorCount := report.Errors()
+	if errorCount == 0 {
+		return nil
+	}
+	if config.Config.Content.AllowInvalid {
+		log.Printf("⚠️ 콘텐츠 검증 오류 %d건을 무시하고 렌더링합니다 (allow-invalid)", errorCount)
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s 오류 %d건 (check-content로 확인, 무시하려면 --allow-invalid)", apperror.ErrInvalidContent, report.ServiceType, report.TargetDate, errorCount)
+}
+
+// reelsValidationRows - 숏폼 콘텐츠를 슬라이드 줄 단위로 변환합니다 (basicSlideTexts와 같은 배치)
+// pronunciations는 프로필의 발음 표기 방식(kr/ipa/both)을 적용한 발음 줄입니다.
+func reelsValidationRows(result *dto.ContentDataResult, profile config.PlatformProfile, pronunciations []string) []validationRow {
+	primary, secondary, tertiary := "english_word", "meaning", "pronunciation_kr"
+	switch {
+	case profile.ContentSource == enum.ContentSourceYoutubeShorts:
+		primary = "word"
+	case profile.ContentType == enum.ContentIdiom:
+		primary = "idiom"
+	case profile.ContentType == enum.ContentSentence:
+		primary, secondary, tertiary = "english_sentence_1", "korean_sentence_1", "pronunciation"
+	}
+	fontSize := profile.FontSize
+
+	rows := make([]validationRow, len(result.Primary))
+	for i := range result.Primary {
+		pronunciationField := tertiary
+		phonetic := strings.TrimSpace(lineAt(result.Phonetic, i))
+		switch {
+		case profile.Pronunciation == enum.PronunciationIPA && phonetic != "":
+			pronunciationField = "phonetic_symbol"
+		case profile.Pronunciation == enum.PronunciationBoth && phonetic != "":
+			pronunciationField = tertiary + ",phonetic_symbol"
+		}
+
+		row := validationRow{Lines: []validationLine{
+			{Field: primary, Text: result.Primary[i], MaxSize: fontSize, Required: true},
+			{Field: secondary, Text: result.Secondary[i], MaxSize: fontSize, Required: true},
+			{Field: pronunciationField, Text: lineAt(pronunciations, i), Format: "( %s )", MaxSize: pronounceMaxFontSize, Required: true},
+		}}
+		if i < len(result.Ids) {
+			row.Id = result.Ids[i]
+		}
+		if phonetic == "" && (profile.Pronunciation == enum.PronunciationIPA || profile.Pronunciation == enum.PronunciationBoth) {
+			row.Lines = append(row.Lines, validationLine{Field: "phonetic_symbol", Text: lineAt(result.Phonetic, i), Missing: "IPA 발음기호가 비어 있어 한글 발음만 표시합니다"})
+		}
+		if primary == "english_sentence_1" {
+			row.Lines = append(row.Lines,
+				validationLine{Field: "english_sentence_2", Text: lineAt(result.PrimaryLine2, i), MaxSize: fontSize, Pair: "korean_sentence_2"},
+				validationLine{Field: "korean_sentence_2", Text: lineAt(result.SecondaryLine2, i), MaxSize: fontSize, Pair: "english_sentence_2"},
+			)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// lineAt - 2번째 줄 배열의 i번째 값 (없으면 빈 문자열)
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
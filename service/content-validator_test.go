@@ -0,0 +1,68 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"testing"
+)
+
+func TestValidateRows(t *testing.T) {
+	// 글자당 size/2 px, 한 줄 최대 600px (120pt에서 10자, 20pt에서 60자)
+	capacity := &textCapacity{
+		maxWidth: 600,
+		measure: func(text string, size float64) int {
+			return int(float64(len([]rune(text))) * size / 2)
+		},
+	}
+	result := &dto.ContentDataResult{
+		Ids:            []int64{11, 12, 13},
+		Primary:        []string{"apple", " I'm on my  way. ", "This sentence is far too long to fit on a single slide even at twenty points"},
+		PrimaryLine2:   []string{"", "See you.", ""},
+		Secondary:      []string{"사과", "가는 중이야.", "한 줄"},
+		SecondaryLine2: []string{"", "", ""},
+		Tertiary:       []string{"애플", "", "디스"},
+	}
+	profile := config.PlatformProfile{ContentType: enum.ContentSentence, ContentSource: enum.ContentSourceShorts, FontSize: 120}
+	rows := reelsValidationRows(result, profile, result.Tertiary)
+
+	type key struct {
+		row      int
+		field    string
+		severity string
+	}
+	got := map[key]bool{}
+	for _, issue := range validateRows(rows, capacity) {
+		if issue.Id != result.Ids[issue.Row-1] {
+			t.Errorf("issue %+v: id mismatch", issue)
+		}
+		got[key{issue.Row, issue.Field, issue.Severity}] = true
+	}
+	want := []key{
+		{2, "english_sentence_1", dto.ValidationWarning}, // 앞뒤 공백 (연속 공백은 따로 보고하지 않음)
+		{2, "pronunciation", dto.ValidationError},        // 빈 발음
+		{2, "korean_sentence_2", dto.ValidationWarning},  // english_sentence_2만 있음
+		{3, "english_sentence_1", dto.ValidationError},   // 20pt에서도 넘침
+	}
+	for _, k := range want {
+		if !got[k] {
+			t.Errorf("missing issue %+v (got %v)", k, got)
+		}
+	}
+	if len(got) != 4 {
+		t.Errorf("issues = %v, want 4 distinct", got)
+	}
+	if got[key{1, "english_sentence_1", dto.ValidationError}] || got[key{1, "english_sentence_1", dto.ValidationWarning}] {
+		t.Errorf("row 1 should be clean: %v", got)
+	}
+}
+
+func TestTextCapacityFit(t *testing.T) {
+	capacity := &textCapacity{maxWidth: 100, measure: func(text string, size float64) int { return int(size) * len(text) }}
+	if size, _, fits := capacity.fit("abc", 75); !fits || size != 25 {
+		t.Errorf("fit = %v, %v, want 25pt", size, fits)
+	}
+	if size, _, fits := capacity.fit("abcdef", 75); fits || size != 25 {
+		t.Errorf("fit = %v, %v, want overflow at 25pt", size, fits)
+	}
+}
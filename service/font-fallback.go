@@ -0,0 +1,138 @@
+package service
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"fmt"
+	"image"
+	"os"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// fallbackFace - 글자마다 glyph가 있는 첫 번째 폰트로 그리고 측정하는 font.Face
+// font.Drawer.DrawString과 font.BoundString이 모두 글자 단위로 Glyph/GlyphBounds/Kern을 호출하므로
+// 측정한 너비와 실제로 그려지는 너비가 같습니다. 어느 폰트에도 없는 글자는 첫 번째 폰트로 그립니다(□).
+type fallbackFace struct {
+	faces []font.Face
+}
+
+// newFallbackFace - fonts 순서대로 같은 크기의 face를 만듭니다 (폰트가 하나면 그 face 그대로)
+func newFallbackFace(fonts []*opentype.Font, size float64, hinting font.Hinting) (font.Face, error) {
+	faces := make([]font.Face, 0, len(fonts))
+	for _, f := range fonts {
+		face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: hinting})
+		if err != nil {
+			for _, opened := range faces {
+				opened.Close()
+			}
+			return nil, err
+		}
+		faces = append(faces, face)
+	}
+	if len(faces) == 1 {
+		return faces[0], nil
+	}
+	return &fallbackFace{faces: faces}, nil
+}
+
+// pick - r의 glyph가 있는 첫 번째 face
+func (f *fallbackFace) pick(r rune) font.Face {
+	for _, face := range f.faces {
+		if _, ok := face.GlyphAdvance(r); ok {
+			return face
+		}
+	}
+	return f.faces[0]
+}
+
+func (f *fallbackFace) Close() error {
+	for _, face := range f.faces {
+		face.Close()
+	}
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.pick(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.pick(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.pick(r).GlyphAdvance(r)
+}
+
+// Kern - 두 글자가 같은 폰트일 때만 커닝 적용
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	face := f.pick(r0)
+	if face != f.pick(r1) {
+		return 0
+	}
+	return face.Kern(r0, r1)
+}
+
+// Metrics - 줄 높이/기준선은 첫 번째(기본) 폰트 기준
+func (f *fallbackFace) Metrics() font.Metrics {
+	return f.faces[0].Metrics()
+}
+
+// missingGlyphs - fonts 어디에도 glyph가 없는 글자 (공백/제어 문자 제외, 중복 제거)
+func missingGlyphs(fonts []*opentype.Font, text string) []rune {
+	face, err := newFallbackFace(fonts, 12, font.HintingNone)
+	if err != nil {
+		return nil
+	}
+	defer face.Close()
+
+	missing := make([]rune, 0)
+	seen := make(map[rune]bool)
+	for _, r := range text {
+		if r <= ' ' || seen[r] {
+			continue
+		}
+		if _, ok := face.GlyphAdvance(r); !ok {
+			seen[r] = true
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+// fontChain - 역할(config.FontRole)의 폰트 목록. 첫 번째가 기본 폰트
+type fontChain struct {
+	fonts []*opentype.Font
+	bytes [][]byte // 슬라이드 캐시 키용 폰트 파일 내용
+}
+
+// loadFontChain - config.FontChain(role)의 폰트를 모두 읽습니다 (하나라도 없으면 ErrMissingTemplate)
+func loadFontChain(role config.FontRole) (*fontChain, error) {
+	chain := &fontChain{}
+	for _, path := range config.FontChain(role) {
+		fontBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 폰트 파일을 읽을 수 없습니다: %v", apperror.ErrMissingTemplate, err)
+		}
+		parsedFont, err := opentype.Parse(fontBytes)
+		if err != nil {
+			return nil, fmt.Errorf("폰트 파싱 실패 (%s): %v", path, err)
+		}
+		chain.fonts = append(chain.fonts, parsedFont)
+		chain.bytes = append(chain.bytes, fontBytes)
+	}
+	return chain, nil
+}
+
+// face - size 크기의 face (글자마다 목록에서 glyph가 있는 첫 번째 폰트 사용)
+func (c *fontChain) face(size float64, hinting font.Hinting) (font.Face, error) {
+	return newFallbackFace(c.fonts, size, hinting)
+}
+
+// missing - 목록의 어느 폰트에도 없는 글자
+func (c *fontChain) missing(text string) []rune {
+	return missingGlyphs(c.fonts, text)
+}
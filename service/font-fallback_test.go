@@ -0,0 +1,70 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+// testdata/glyfTest.ttf - golang.org/x/image/font/testdata의 숫자(0, 1, 5~9)만 있는 폰트 (LICENSE-golang-x-image)
+func TestFallbackFaceDrawsMissingGlyphsFromFallback(t *testing.T) {
+	regularPath := filepath.Join(t.TempDir(), "Go-Regular.ttf")
+	if err := os.WriteFile(regularPath, goregular.TTF, 0644); err != nil {
+		t.Fatal(err)
+	}
+	saved := config.Config
+	t.Cleanup(func() { config.Config = saved })
+	config.Config.FontPath = "testdata/glyfTest.ttf"
+	config.Config.Fonts.Regular = []string{regularPath}
+	config.Config.PhoneticFontPath = ""
+
+	chain, err := loadFontChain(config.FontRoleRegular)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain.fonts) != 2 {
+		t.Fatalf("chain has %d fonts, want 2", len(chain.fonts))
+	}
+	primary := &fontChain{fonts: chain.fonts[:1]}
+	fallback := &fontChain{fonts: chain.fonts[1:]}
+
+	const text = "15 apple"
+	if missing := string(primary.missing(text)); missing != "aple" {
+		t.Errorf("primary missing = %q, want %q", missing, "aple")
+	}
+	if missing := chain.missing(text); len(missing) != 0 {
+		t.Errorf("chain missing = %q, want none", string(missing))
+	}
+
+	chainFace, _ := chain.face(40, font.HintingFull)
+	primaryFace, _ := primary.face(40, font.HintingFull)
+	fallbackFace, _ := fallback.face(40, font.HintingFull)
+	defer chainFace.Close()
+	defer primaryFace.Close()
+	defer fallbackFace.Close()
+
+	// 기본 폰트에 있는 글자는 기본 폰트로, 없는 글자는 보조 폰트로 그림
+	if a, b := font.MeasureString(chainFace, "15"), font.MeasureString(primaryFace, "15"); a != b {
+		t.Errorf("digits width = %v, want primary width %v", a, b)
+	}
+	if a, b := font.MeasureString(chainFace, "apple"), font.MeasureString(primaryFace, "apple"); a == b {
+		t.Errorf("letters width = %v, want different from primary-only (notdef) width", a)
+	}
+	if a, b := font.MeasureString(chainFace, "apple"), font.MeasureString(fallbackFace, "apple"); a != b {
+		t.Errorf("letters width = %v, want fallback width %v", a, b)
+	}
+	if dr, mask, _, _, ok := chainFace.Glyph(fixed.P(0, 40), 'a'); !ok || mask == nil || dr.Empty() {
+		t.Errorf("Glyph('a') = %v, %v, want a drawn glyph from the fallback font", dr, ok)
+	}
+
+	// BoundString(측정)과 DrawString(그리기)이 같은 advance를 사용
+	bounds, advance := font.BoundString(chainFace, text)
+	if advance != font.MeasureString(chainFace, text) || bounds.Max.X <= bounds.Min.X {
+		t.Errorf("bounds = %v, advance = %v", bounds, advance)
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"auto-video-service/apperror"
 	"auto-video-service/config"
 	"auto-video-service/enum"
 	"fmt"
@@ -11,7 +12,6 @@ import (
 	"os"
 
 	"golang.org/x/image/font"
-	"golang.org/x/image/font/opentype"
 	"golang.org/x/image/math/fixed"
 
 	"github.com/disintegration/imaging"
@@ -48,12 +48,41 @@ const (
 	subtitleBlurSigma  = 3.0  // 서브타이틀 블러 강도
 )
 
+// imageRenderVersion - 슬라이드 렌더링 방식이 바뀌면 올려서 기존 이미지 캐시를 무효화합니다
+const imageRenderVersion = "1"
+
 // ImageService 이미지 생성 서비스
-type ImageService struct{}
+type ImageService struct {
+	cache *AssetCache // 같은 템플릿/폰트/색상/텍스트의 슬라이드 재사용
+}
 
 // NewImageService 새로운 이미지 서비스 생성
 func NewImageService() *ImageService {
-	return &ImageService{}
+	return &ImageService{cache: DefaultAssetCache()}
+}
+
+// slideCacheKeyBase - 템플릿과 폰트 목록(보조 폰트 포함)의 파일 내용을 포함한 슬라이드 캐시 키의 공통 부분
+func (s *ImageService) slideCacheKeyBase(kind string, templatePath string, fontBytes ...[]byte) []string {
+	if !s.cache.Enabled() {
+		return nil
+	}
+	templateDigest, err := fileDigest(templatePath)
+	if err != nil {
+		return nil
+	}
+	base := []string{kind, imageRenderVersion, templateDigest}
+	for _, b := range fontBytes {
+		base = append(base, CacheKey(string(b)))
+	}
+	return base
+}
+
+// slideCacheKey - 슬라이드 한 장의 캐시 키 (공통 부분이 없으면 캐시 사용 안 함)
+func slideCacheKey(base []string, parts ...string) string {
+	if base == nil {
+		return ""
+	}
+	return CacheKey(append(append([]string{}, base...), parts...)...)
 }
 
 // TextRenderOptions 텍스트 렌더링 옵션
@@ -120,6 +149,37 @@ func (s *ImageService) GenerateBasicImages(
 	return s.GenerateBasicImagesWithFontSize(imagePath, eng, []string{}, kor, []string{}, pronounce, outputPrefix, count, 120, enum.TextColorBeige)
 }
 
+// basicSlideTexts - 숏폼 슬라이드 i(0부터)에 들어갈 텍스트 (첫째/둘째/셋째 줄)
+// 짝수 번째는 한국어, 홀수 번째는 영어 + 발음입니다. plan 모드도 같은 규칙을 사용합니다.
+func basicSlideTexts(i int, eng []string, engLine2 []string, kor []string, korLine2 []string, pronounce []string) (string, string, string) {
+	var text, secondText, thirdText string
+	if i%2 == 0 { // 짝수 번째 (0, 2, 4, ...) - 한국어
+		text = kor[i/2]
+		// SS 타입: korLine2가 있으면 두 번째 줄로 표시
+		if len(korLine2) > i/2 && korLine2[i/2] != "" {
+			secondText = korLine2[i/2]
+		}
+	} else { // 홀수 번째 (1, 3, 5, ...) - 영어
+		text = eng[i/2]
+		// SS 타입: engLine2가 있으면 두 번째 줄로 표시
+		if len(engLine2) > i/2 && engLine2[i/2] != "" {
+			secondText = engLine2[i/2]
+		}
+		// 발음은 항상 세 번째 줄
+		thirdText = "( " + pronounce[i/2] + " )"
+	}
+	return text, secondText, thirdText
+}
+
+// longformSlideTexts - 롱폼 슬라이드 i(0부터)에 들어갈 텍스트 (본문/발음)
+func longformSlideTexts(i int, eng []string, kor []string, pronounce []string) (string, string) {
+	if i%2 == 0 { // 짝수 번째 - 한국어
+		return kor[i/2], ""
+	}
+	// 홀수 번째 - 영어
+	return eng[i/2], "( " + pronounce[i/2] + " )"
+}
+
 // GenerateBasicImagesWithFontSize 단어 학습용 이미지들을 폰트 크기를 지정하여 생성합니다
 func (s *ImageService) GenerateBasicImagesWithFontSize(
 	imagePath string,
@@ -136,7 +196,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 	// 1. 이미지 불러오기
 	existingImageFile, err := os.Open(imagePath)
 	if err != nil {
-		return fmt.Errorf("이미지 파일을 열 수 없습니다: %v", err)
+		return fmt.Errorf("%w: 이미지 파일을 열 수 없습니다: %v", apperror.ErrMissingTemplate, err)
 	}
 	defer existingImageFile.Close()
 
@@ -146,13 +206,9 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 	}
 
 	// 2. 폰트 불러오기
-	fontBytes, err := os.ReadFile(config.Config.FontPath)
-	if err != nil {
-		return fmt.Errorf("폰트 파일을 읽을 수 없습니다: %v", err)
-	}
-	parsedFont, err := opentype.Parse(fontBytes)
+	fonts, err := loadFontChain(config.FontRoleRegular)
 	if err != nil {
-		return fmt.Errorf("폰트 파싱 실패: %v", err)
+		return err
 	}
 
 	// 3. 배열 길이 검증
@@ -178,30 +234,22 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 		textColor = color.RGBA{R: 255, G: 255, B: 255, A: 255} // 흰색 (기본값)
 	}
 
+	cacheKeyBase := s.slideCacheKeyBase("basic-slide", imagePath, fonts.bytes...)
+
 	// 5. 이미지들 생성
 	for i := 0; i < count; i++ {
 		// 원본 이미지 복사
 		rgba := image.NewRGBA(img.Bounds())
 		draw.Draw(rgba, rgba.Bounds(), img, image.Point{}, draw.Src)
 
-		var text string
-		var secondText string
-		var thirdText string
+		text, secondText, thirdText := basicSlideTexts(i, eng, engLine2, kor, korLine2, pronounce)
 
-		if i%2 == 0 { // 짝수 번째 (0, 2, 4, ...) - 한국어
-			text = kor[i/2]
-			// SS 타입: korLine2가 있으면 두 번째 줄로 표시
-			if len(korLine2) > i/2 && korLine2[i/2] != "" {
-				secondText = korLine2[i/2]
-			}
-		} else { // 홀수 번째 (1, 3, 5, ...) - 영어
-			text = eng[i/2]
-			// SS 타입: engLine2가 있으면 두 번째 줄로 표시
-			if len(engLine2) > i/2 && engLine2[i/2] != "" {
-				secondText = engLine2[i/2]
-			}
-			// 발음은 항상 세 번째 줄
-			thirdText = "( " + pronounce[i/2] + " )"
+		// 같은 슬라이드가 캐시에 있으면 렌더링 생략
+		outputFileName := fmt.Sprintf("%s_%02d.png", outputPrefix, i+1)
+		cacheKey := slideCacheKey(cacheKeyBase, fmt.Sprintf("%.1f", fontSize), string(textColorEnum), text, secondText, thirdText)
+		if s.cache.Restore(cacheKindImage, cacheKey, outputFileName) {
+			fmt.Printf("이미지 %d 캐시 사용: %s\n", i+1, outputFileName)
+			continue
 		}
 
 		// ===== 글자 길이에 따른 동적 폰트 크기 조절 로직 시작 =====
@@ -220,11 +268,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 
 		for {
 			var faceErr error
-			face, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-				Size:    currentFontSize,
-				DPI:     72,
-				Hinting: font.HintingFull,
-			})
+			face, faceErr = fonts.face(currentFontSize, font.HintingFull)
 			if faceErr != nil {
 				return fmt.Errorf("폰트 페이스 생성 실패: %v", faceErr)
 			}
@@ -277,11 +321,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 			// 두 번째 텍스트도 동적 폰트 크기 조절
 			for {
 				var faceErr error
-				secondFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-					Size:    secondFontSize,
-					DPI:     72,
-					Hinting: font.HintingFull,
-				})
+				secondFace, faceErr = fonts.face(secondFontSize, font.HintingFull)
 				if faceErr != nil {
 					return fmt.Errorf("두 번째 줄 폰트 페이스 생성 실패: %v", faceErr)
 				}
@@ -324,11 +364,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 
 				for {
 					var faceErr error
-					thirdFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-						Size:    thirdFontSize,
-						DPI:     72,
-						Hinting: font.HintingFull,
-					})
+					thirdFace, faceErr = fonts.face(thirdFontSize, font.HintingFull)
 					if faceErr != nil {
 						secondFace.Close()
 						return fmt.Errorf("세 번째 줄 폰트 페이스 생성 실패: %v", faceErr)
@@ -374,11 +410,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 
 			for {
 				var faceErr error
-				thirdFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-					Size:    thirdFontSize,
-					DPI:     72,
-					Hinting: font.HintingFull,
-				})
+				thirdFace, faceErr = fonts.face(thirdFontSize, font.HintingFull)
 				if faceErr != nil {
 					return fmt.Errorf("발음 폰트 페이스 생성 실패: %v", faceErr)
 				}
@@ -415,7 +447,6 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 		}
 
 		// 이미지 저장
-		outputFileName := fmt.Sprintf("%s_%02d.png", outputPrefix, i+1)
 		outputFile, err := os.Create(outputFileName)
 		if err != nil {
 			return fmt.Errorf("출력 파일을 생성할 수 없습니다: %v", err)
@@ -428,6 +459,7 @@ func (s *ImageService) GenerateBasicImagesWithFontSize(
 		}
 		outputFile.Close()
 
+		s.cache.Store(cacheKindImage, cacheKey, outputFileName)
 		fmt.Printf("이미지 %d 생성 완료: %s\n", i+1, outputFileName)
 	}
 
@@ -460,7 +492,7 @@ func (s *ImageService) GenerateEKImagesWithFontSize(
 	// 1. 이미지 불러오기
 	existingImageFile, err := os.Open(imagePath)
 	if err != nil {
-		return fmt.Errorf("이미지 파일을 열 수 없습니다: %v", err)
+		return fmt.Errorf("%w: 이미지 파일을 열 수 없습니다: %v", apperror.ErrMissingTemplate, err)
 	}
 	defer existingImageFile.Close()
 
@@ -471,22 +503,13 @@ func (s *ImageService) GenerateEKImagesWithFontSize(
 	}
 
 	// 2. 폰트 불러오기
-	fontBytes, err := os.ReadFile(config.Config.FontPath)
-	if err != nil {
-		return fmt.Errorf("폰트 파일을 읽을 수 없습니다: %v", err)
-	}
-
-	parsedFont, err := opentype.Parse(fontBytes)
+	fonts, err := loadFontChain(config.FontRoleRegular)
 	if err != nil {
-		return fmt.Errorf("폰트 파싱 실패: %v", err)
+		return err
 	}
 
 	// 폰트 옵션 설정
-	face, err := opentype.NewFace(parsedFont, &opentype.FaceOptions{
-		Size:    fontSize, // 폰트 크기 (변수로 처리)
-		DPI:     72,       // DPI (Dots Per Inch)
-		Hinting: font.HintingNone,
-	})
+	face, err := fonts.face(fontSize, font.HintingNone)
 	if err != nil {
 		return fmt.Errorf("폰트 페이스 생성 실패: %v", err)
 	}
@@ -565,11 +588,7 @@ func (s *ImageService) GenerateEKImagesWithFontSize(
 
 			for {
 				var faceErr error
-				smallFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-					Size:    smallFontSize,
-					DPI:     72,
-					Hinting: font.HintingNone,
-				})
+				smallFace, faceErr = fonts.face(smallFontSize, font.HintingNone)
 				if faceErr != nil {
 					return fmt.Errorf("발음 폰트 페이스 생성 실패: %v", faceErr)
 				}
@@ -636,7 +655,7 @@ func (s *ImageService) SetWordCountOnImage(
 	// 1. 이미지 불러오기
 	existingImageFile, err := os.Open(imagePath)
 	if err != nil {
-		return fmt.Errorf("이미지 파일을 열 수 없습니다: %v", err)
+		return fmt.Errorf("%w: 이미지 파일을 열 수 없습니다: %v", apperror.ErrMissingTemplate, err)
 	}
 	defer existingImageFile.Close()
 
@@ -647,22 +666,13 @@ func (s *ImageService) SetWordCountOnImage(
 	}
 
 	// 2. 폰트 불러오기
-	fontBytes, err := os.ReadFile(config.Config.FontPath)
-	if err != nil {
-		return fmt.Errorf("폰트 파일을 읽을 수 없습니다: %v", err)
-	}
-
-	parsedFont, err := opentype.Parse(fontBytes)
+	fonts, err := loadFontChain(config.FontRoleRegular)
 	if err != nil {
-		return fmt.Errorf("폰트 파싱 실패: %v", err)
+		return err
 	}
 
 	// 폰트 옵션 설정 (wordCount용으로 크기 조정)
-	face, err := opentype.NewFace(parsedFont, &opentype.FaceOptions{
-		Size:    90, // wordCount용 폰트 크기 (80에서 100으로 증가)
-		DPI:     72,
-		Hinting: font.HintingNone,
-	})
+	face, err := fonts.face(90, font.HintingNone) // wordCount용 폰트 크기 (80에서 100으로 증가)
 	if err != nil {
 		return fmt.Errorf("폰트 페이스 생성 실패: %v", err)
 	}
@@ -731,7 +741,7 @@ func (s *ImageService) SetTitleOnImage(title, subTitle, imagePath, outputPath st
 	// 1. Load image
 	existingImageFile, err := os.Open(imagePath)
 	if err != nil {
-		return fmt.Errorf("could not open image file: %v", err)
+		return fmt.Errorf("%w: could not open image file: %v", apperror.ErrMissingTemplate, err)
 	}
 	defer existingImageFile.Close()
 
@@ -742,14 +752,9 @@ func (s *ImageService) SetTitleOnImage(title, subTitle, imagePath, outputPath st
 	}
 
 	// 2. Load title font
-	fontBytes, err := os.ReadFile(config.Config.TitleFontPath)
-	if err != nil {
-		return fmt.Errorf("could not read font file: %v", err)
-	}
-
-	parsedFont, err := opentype.Parse(fontBytes)
+	fonts, err := loadFontChain(config.FontRoleTitle)
 	if err != nil {
-		return fmt.Errorf("failed to parse font: %v", err)
+		return err
 	}
 
 	// 3. Create image
@@ -773,11 +778,7 @@ func (s *ImageService) SetTitleOnImage(title, subTitle, imagePath, outputPath st
 
 	for {
 		var faceErr error
-		titleFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-			Size:    titleFontSize,
-			DPI:     72,
-			Hinting: font.HintingFull,
-		})
+		titleFace, faceErr = fonts.face(titleFontSize, font.HintingFull)
 		if faceErr != nil {
 			return fmt.Errorf("failed to create font face: %v", faceErr)
 		}
@@ -854,13 +855,9 @@ func (s *ImageService) SetTitleOnImage(title, subTitle, imagePath, outputPath st
 	// Draw subtitle below title if provided
 	if subTitle != "" {
 		// 서브타이틀용 폰트 로딩 (NanumGothicExtraBold)
-		subFontBytes, err := os.ReadFile(config.Config.BoldFontPath)
-		if err != nil {
-			return fmt.Errorf("could not read subtitle font file: %v", err)
-		}
-		subParsedFont, err := opentype.Parse(subFontBytes)
+		subFonts, err := loadFontChain(config.FontRoleBold)
 		if err != nil {
-			return fmt.Errorf("failed to parse subtitle font: %v", err)
+			return err
 		}
 
 		// === 서브타이틀 동적 폰트 크기 조절 ===
@@ -872,11 +869,7 @@ func (s *ImageService) SetTitleOnImage(title, subTitle, imagePath, outputPath st
 
 		for {
 			var faceErr error
-			subFace, faceErr = opentype.NewFace(subParsedFont, &opentype.FaceOptions{
-				Size:    subFontSize,
-				DPI:     72,
-				Hinting: font.HintingFull,
-			})
+			subFace, faceErr = subFonts.face(subFontSize, font.HintingFull)
 			if faceErr != nil {
 				return fmt.Errorf("failed to create subtitle font face: %v", faceErr)
 			}
@@ -969,7 +962,7 @@ func (s *ImageService) GenerateLongformImages(
 	// 1. 이미지 불러오기
 	existingImageFile, err := os.Open(imagePath)
 	if err != nil {
-		return fmt.Errorf("이미지 파일을 열 수 없습니다: %v", err)
+		return fmt.Errorf("%w: 이미지 파일을 열 수 없습니다: %v", apperror.ErrMissingTemplate, err)
 	}
 	defer existingImageFile.Close()
 
@@ -979,13 +972,9 @@ func (s *ImageService) GenerateLongformImages(
 	}
 
 	// 2. 폰트 불러오기 (롱폼 전용 볼드 폰트)
-	fontBytes, err := os.ReadFile(config.Config.BoldFontPath)
+	fonts, err := loadFontChain(config.FontRoleBold)
 	if err != nil {
-		return fmt.Errorf("폰트 파일을 읽을 수 없습니다: %v", err)
-	}
-	parsedFont, err := opentype.Parse(fontBytes)
-	if err != nil {
-		return fmt.Errorf("폰트 파싱 실패: %v", err)
+		return err
 	}
 
 	// 3. 색상 정의
@@ -1007,6 +996,7 @@ func (s *ImageService) GenerateLongformImages(
 	imgWidth := img.Bounds().Dx()
 	imgHeight := img.Bounds().Dy()
 	maxTextWidth := int(float64(imgWidth) * enum.LongformMaxTextWidthRatio)
+	cacheKeyBase := s.slideCacheKeyBase("longform-slide", imagePath, fonts.bytes...)
 
 	// 5. 이미지들 생성
 	for i := 0; i < count; i++ {
@@ -1014,14 +1004,14 @@ func (s *ImageService) GenerateLongformImages(
 		rgba := image.NewRGBA(img.Bounds())
 		draw.Draw(rgba, rgba.Bounds(), img, image.Point{}, draw.Src)
 
-		var text string
-		var secondText string // 발음
+		text, secondText := longformSlideTexts(i, eng, kor, pronounce)
 
-		if i%2 == 0 { // 짝수 번째 - 한국어
-			text = kor[i/2]
-		} else { // 홀수 번째 - 영어
-			text = eng[i/2]
-			secondText = "( " + pronounce[i/2] + " )"
+		// 같은 슬라이드가 캐시에 있으면 렌더링 생략
+		outputFileName := fmt.Sprintf("%s_%02d.png", outputPrefix, i+1)
+		cacheKey := slideCacheKey(cacheKeyBase, text, secondText)
+		if s.cache.Restore(cacheKindImage, cacheKey, outputFileName) {
+			fmt.Printf("이미지 %d 캐시 사용: %s\n", i+1, outputFileName)
+			continue
 		}
 
 		// 동적 폰트 크기 조절
@@ -1030,11 +1020,7 @@ func (s *ImageService) GenerateLongformImages(
 
 		for {
 			var faceErr error
-			face, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-				Size:    currentFontSize,
-				DPI:     72,
-				Hinting: font.HintingFull,
-			})
+			face, faceErr = fonts.face(currentFontSize, font.HintingFull)
 			if faceErr != nil {
 				return fmt.Errorf("폰트 페이스 생성 실패: %v", faceErr)
 			}
@@ -1079,11 +1065,7 @@ func (s *ImageService) GenerateLongformImages(
 			// 동적 폰트 크기 조절
 			for {
 				var faceErr error
-				smallFace, faceErr = opentype.NewFace(parsedFont, &opentype.FaceOptions{
-					Size:    smallFontSize,
-					DPI:     72,
-					Hinting: font.HintingFull,
-				})
+				smallFace, faceErr = fonts.face(smallFontSize, font.HintingFull)
 				if faceErr != nil {
 					return fmt.Errorf("발음 폰트 페이스 생성 실패: %v", faceErr)
 				}
@@ -1120,7 +1102,6 @@ func (s *ImageService) GenerateLongformImages(
 		}
 
 		// 이미지 저장
-		outputFileName := fmt.Sprintf("%s_%02d.png", outputPrefix, i+1)
 		outputFile, err := os.Create(outputFileName)
 		if err != nil {
 			return fmt.Errorf("출력 파일을 생성할 수 없습니다: %v", err)
@@ -1133,6 +1114,7 @@ func (s *ImageService) GenerateLongformImages(
 		}
 		outputFile.Close()
 
+		s.cache.Store(cacheKindImage, cacheKey, outputFileName)
 		fmt.Printf("이미지 %d 생성 완료: %s\n", i+1, outputFileName)
 	}
 
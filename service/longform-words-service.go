@@ -1,59 +1,84 @@
 package service
 
 import (
+	"auto-video-service/apperror"
 	"auto-video-service/config"
+	"auto-video-service/contentpack"
+	"auto-video-service/dto"
 	"auto-video-service/entity"
-	"auto-video-service/repository"
+	"auto-video-service/enum"
+	"auto-video-service/progress"
 	"context"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"time"
 )
 
+const (
+	longformKoreanSayRate = 125 // 본문 한국어 음성 속도 (say -r)
+	longformKoreanSilence = 1.0 // 한국어 클립 끝 무음 (초)
+	longformEnglishRepeat = 2   // 영어 반복 횟수 (반복 사이 2초 무음)
+	longformTitleSayRate  = 150 // 타이틀 음성 속도
+	longformTitleSilence  = 1.5 // 타이틀/서브타이틀 뒤 무음 (초)
+	longformEnglishGapSec = 2.0 // VideoService의 영어 반복 사이 무음
+)
+
 type LongformWordService struct{}
 
 func NewLongformWordService() *LongformWordService {
 	return &LongformWordService{}
 }
 
-func (s *LongformWordService) CreateLongformWords(ctx context.Context, targetDate time.Time, serviceType string) {
-	title, longformWords, err := s.getTitleByDate(ctx, targetDate)
+// Produce - 유튜브 롱폼 영상 생성 (타이틀 → 스타트 코멘트 → 본문)
+func (s *LongformWordService) Produce(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoCreationResponse, error) {
+	response := dto.VideoCreationResponse{Success: false}
+	tracker := progress.FromContext(ctx)
+
+	contentStage := tracker.Stage(progress.StageContent, 0)
+	title, longformWords, err := s.GetTitleByDate(ctx, request.TargetDate)
+	contentStage.End()
 	if err != nil {
-		log.Fatalf("데이터 조회 실패: %v", err)
+		return s.fail(response, fmt.Errorf("데이터 조회 실패: %w", err))
 	}
+	response.ContentCount = len(longformWords)
+	for _, word := range longformWords {
+		response.ItemIds = append(response.ItemIds, word.Id)
+	}
+	response.Settings = &dto.GenerationSettings{Template: config.Config.Paths.Templates.BackgroundImg}
 
-	// 서비스 초기화
-	imageService := NewImageService()
-	longformConfig := VideoConfig{Width: 1920, Height: 1080}
-	videoService := NewVideoService(imageService, longformConfig)
-	audioService := NewAudioService()
-
-	// 디렉토리 생성 (config에서 경로 인용)
-	audioDir := config.Config.Paths.TempAudioDir
-	if err := os.MkdirAll(audioDir, 0755); err != nil {
-		log.Fatalf("audio 디렉토리 생성 실패: %v", err)
+	// 렌더링 전 콘텐츠 검증 (오류가 있으면 중단)
+	report, err := s.validate(request, longformWords)
+	if err == nil {
+		err = enforceValidation(report)
 	}
-	videosDir := config.Config.Paths.TempVideosDir
-	if err := os.MkdirAll(videosDir, 0755); err != nil {
-		log.Fatalf("videos 디렉토리 생성 실패: %v", err)
+	if err != nil {
+		return s.fail(response, err)
 	}
 
-	// images 디렉토리 생성
-	imagesDir := config.Config.Paths.TempImagesDir
-	if err := os.MkdirAll(imagesDir, 0755); err != nil {
-		log.Fatalf("images 디렉토리 생성 실패: %v", err)
+	// 작업별 임시 디렉토리 생성 (defer로 최종적으로 정리)
+	workspace, err := NewWorkspace(request)
+	if err != nil {
+		return s.fail(response, err)
 	}
+	defer workspace.Cleanup()
+	audioDir := workspace.AudioDir
+	videosDir := workspace.VideosDir
+	imagesDir := workspace.ImagesDir
 
-	// defer로 최종적으로 임시 파일 정리
-	defer s.cleanupFiles()
+	// 서비스 초기화
+	imageService := NewImageService()
+	longformConfig := VideoConfig{Width: 1920, Height: 1080, WorkDir: videosDir}
+	videoService := NewVideoService(imageService, longformConfig)
+	audioService := NewAudioService(workspace.Root)
 
 	// 1. 타이틀 시퀀스 생성 (이미지, 음성, 비디오)
-	titleVideoPath, err := s.createTitleSequence(title.Title, title.SubTitle, imageService, audioService, videoService, audioDir, videosDir)
+	titleStage := tracker.Stage(progress.StageTitle, 0)
+	titleVideoPath, err := s.createTitleSequence(ctx, title.Title, title.SubTitle, imageService, audioService, videoService, imagesDir, audioDir, videosDir)
+	titleStage.End()
 	if err != nil {
-		log.Fatalf("타이틀 시퀀스 생성 실패: %v", err)
+		return s.fail(response, fmt.Errorf("타이틀 시퀀스 생성 실패: %w", err))
 	}
 	videoPaths := []string{titleVideoPath}
 
@@ -62,130 +87,274 @@ func (s *LongformWordService) CreateLongformWords(ctx context.Context, targetDat
 	if _, err := os.Stat(startCommentVideoPath); os.IsNotExist(err) {
 		log.Println("스타트 코멘트 비디오가 없습니다. 자동 생성합니다...")
 		startService := NewStartService()
-		startService.CreateStartCommentVideo(ctx, targetDate, serviceType)
+		startRequest := dto.VideoCreationRequest{TargetDate: request.TargetDate, ServiceType: string(enum.Start)}
+		if _, err := startService.Produce(ctx, startRequest); err != nil {
+			return s.fail(response, fmt.Errorf("스타트 코멘트 비디오 생성 실패: %w", err))
+		}
 		log.Println("✅ 스타트 코멘트 비디오 생성 완료!")
 	}
 	videoPaths = append(videoPaths, startCommentVideoPath)
 	log.Println("✅ 스타트 코멘트 비디오 연결 완료!")
 
 	// 2. 본문 이미지 생성
-	words := make([]string, len(longformWords))
-	meanings := make([]string, len(longformWords))
-	pronunciations := make([]string, len(longformWords))
-	for i, lw := range longformWords {
-		words[i] = lw.Word
-		meanings[i] = lw.Meaning
-		pronunciations[i] = lw.PronunciationKr
-	}
+	words, meanings, pronunciations := s.splitLongformWords(longformWords)
 
-	if err := imageService.GenerateLongformImages(
+	imagesStage := tracker.Stage(progress.StageImages, 0)
+	err = imageService.GenerateLongformImages(
 		config.Config.Paths.Templates.BackgroundImg,
 		words,
 		meanings,
 		pronunciations,
 		filepath.Join(imagesDir, "output"),
 		len(longformWords)*2,
-	); err != nil {
-		log.Fatalf("이미지 생성 실패: %v", err)
+	)
+	imagesStage.End()
+	if err != nil {
+		return s.fail(response, fmt.Errorf("이미지 생성 실패: %w", err))
 	}
 	log.Println("✅ 본문 이미지 생성 완료!")
 
-	// 3. 본문 음성 생성
-	log.Println("🎤 영어 단어 원어민 음성을 생성합니다...")
-	for i, word := range words {
-		audioPath := fmt.Sprintf("%s/eng_%d.mp3", audioDir, i)
-		if err := audioService.CreateNativeEnglishAudio(word, audioPath, false); err != nil {
-			log.Fatalf("영어 원어민 음성 생성 실패 (%s): %v", word, err)
+	// 3. 본문 음성 및 비디오 생성
+	// 단어별로 서로 독립적이므로 작업자 수만큼 동시에 생성하고, 결과는 인덱스 순서대로 연결
+	// 한국어: 1초 무음, 영어: 2초 무음 (반복 사이에도 2초 무음)
+	bodyVideoPaths := make([]string, len(longformWords)*2)
+	workers := config.RenderWorkers()
+	log.Printf("🎤 본문 음성 및 영상을 생성합니다... (동시 작업 %d개)", workers)
+	renderStage := tracker.Stage(progress.StageRender, len(longformWords))
+	err = runParallel(ctx, len(longformWords), workers, func(ctx context.Context, i int) error {
+		englishAudioPath := fmt.Sprintf("%s/eng_%d.mp3", audioDir, i)
+		if err := audioService.CreateNativeEnglishAudio(ctx, words[i], englishAudioPath, false); err != nil {
+			return fmt.Errorf("영어 원어민 음성 생성 실패 (%s): %w", words[i], err)
+		}
+		koreanAudioPath := fmt.Sprintf("%s/kor_%d.mp3", audioDir, i)
+		if err := audioService.CreateKoreanAudioWithRate(ctx, meanings[i], koreanAudioPath, longformKoreanSayRate); err != nil {
+			return fmt.Errorf("한국어 음성 생성 실패 (%s): %w", meanings[i], err)
 		}
-	}
 
-	log.Println("🎤 한국어 단어 음성을 생성합니다...")
-	for i, meaning := range meanings {
-		audioPath := fmt.Sprintf("%s/kor_%d.mp3", audioDir, i)
-		if err := audioService.CreateKoreanAudioWithRate(meaning, audioPath, 125); err != nil {
-			log.Fatalf("한국어 음성 생성 실패 (%s): %v", meaning, err)
+		// 짝수 - 한국어
+		korIndex := i * 2
+		korVideoPath := filepath.Join(videosDir, fmt.Sprintf("video_%d.mp4", korIndex))
+		korImagePath := fmt.Sprintf("%s/output_%02d.png", imagesDir, korIndex+1)
+		if err := videoService.CreateVideoWithKorean(ctx, korImagePath, koreanAudioPath, korVideoPath, longformKoreanSilence); err != nil {
+			return fmt.Errorf("한국어 영상 생성 실패 (%d): %w", korIndex, err)
 		}
-	}
-	log.Println("✅ 본문 음성 파일 생성 완료!")
 
-	// 4. 본문 비디오 생성
-	// 한국어: 1초 무음, 영어: 2초 무음 (반복 사이에도 2초 무음)
-	for i := 0; i < len(longformWords)*2; i++ {
-		var videoFileName string
-		if i%2 == 0 { // 짝수 - 한국어
-			imagePath := fmt.Sprintf("%s/output_%02d.png", imagesDir, i+1)
-			koreanAudioPath := fmt.Sprintf("%s/kor_%d.mp3", audioDir, i/2)
-			videoFileName = fmt.Sprintf("video_%d.mp4", i)
-			if err := videoService.CreateVideoWithKorean(imagePath, koreanAudioPath, filepath.Join(videosDir, videoFileName), 1); err != nil {
-				log.Fatalf("한국어 영상 생성 실패 (%d): %v", i, err)
-			}
-		} else { // 홀수 - 영어
-			imagePath := fmt.Sprintf("%s/output_%02d.png", imagesDir, i+1)
-			englishAudioPath := fmt.Sprintf("%s/eng_%d.mp3", audioDir, i/2)
-			videoFileName = fmt.Sprintf("video_%d.mp4", i)
-			// 영어 2회 반복, 반복 사이 2초 무음, 끝에 무음 없음
-			if err := videoService.CreateVideoWithEnglishRepeat(imagePath, englishAudioPath, filepath.Join(videosDir, videoFileName), 0, 2); err != nil {
-				log.Fatalf("영어 영상 생성 실패 (%d): %v", i, err)
-			}
+		// 홀수 - 영어 (2회 반복, 반복 사이 2초 무음, 끝에 무음 없음)
+		engIndex := i*2 + 1
+		engVideoPath := filepath.Join(videosDir, fmt.Sprintf("video_%d.mp4", engIndex))
+		engImagePath := fmt.Sprintf("%s/output_%02d.png", imagesDir, engIndex+1)
+		if err := videoService.CreateVideoWithEnglishRepeat(ctx, engImagePath, englishAudioPath, engVideoPath, 0, longformEnglishRepeat); err != nil {
+			return fmt.Errorf("영어 영상 생성 실패 (%d): %w", engIndex, err)
 		}
-		videoPaths = append(videoPaths, filepath.Join(videosDir, videoFileName))
-		log.Printf("📹 영상 생성 완료: %d/%d", i+1, len(longformWords)*2)
+
+		bodyVideoPaths[korIndex] = korVideoPath
+		bodyVideoPaths[engIndex] = engVideoPath
+		log.Printf("📹 영상 생성 완료: %d번 단어 (전체 %d)", i+1, len(longformWords))
+		renderStage.Done()
+		return nil
+	})
+	renderStage.End()
+	if err != nil {
+		return s.fail(response, err)
 	}
+	videoPaths = append(videoPaths, bodyVideoPaths...)
 	log.Println("✅ 개별 영상 생성 완료!")
 
 	// 5. 최종 영상 합치기
-	finalVideoDir := config.Config.Paths.FinalVideoDir
-	if err := os.MkdirAll(finalVideoDir, 0755); err != nil {
-		log.Fatalf("final-video 디렉토리 생성 실패: %v", err)
+	finalFileName := request.OutputFileName
+	if err := os.MkdirAll(filepath.Dir(finalFileName), 0755); err != nil {
+		return s.fail(response, fmt.Errorf("final-video 디렉토리 생성 실패: %w", err))
 	}
-	finalFileName := fmt.Sprintf("%s/%02d%02d%02d_longform.mp4", finalVideoDir, targetDate.Year()%100, targetDate.Month(), targetDate.Day())
-	if err = videoService.ConcatenateVideos(videoPaths, finalFileName); err != nil {
-		log.Fatalf("영상 합치기 실패: %v", err)
+	concatStage := tracker.Stage(progress.StageConcat, 0)
+	err = videoService.ConcatenateVideos(ctx, videoPaths, finalFileName)
+	concatStage.End()
+	if err != nil {
+		return s.fail(response, fmt.Errorf("영상 합치기 실패: %w", err))
 	}
 	log.Println("✅ 최종 영상 생성 완료!")
 
 	// 6. 중간 파일 정리 (defer에서 처리하지만 명시적으로 로그 남김)
 	log.Println("✅ 중간 파일 정리 완료!")
+
+	response.FinalFileName = finalFileName
+	response.Success = true
+	return response, nil
+}
+
+// fail - 실패 응답과 에러를 함께 반환합니다
+func (s *LongformWordService) fail(response dto.VideoCreationResponse, err error) (dto.VideoCreationResponse, error) {
+	response.Error = err
+	return response, err
 }
 
-func (s *LongformWordService) cleanupFiles() {
-	log.Println("🧹 임시 파일 및 디렉토리 정리 중...")
-	if err := os.RemoveAll(config.Config.Paths.TempDir); err != nil {
-		log.Printf("임시 디렉토리 삭제 실패: %v", err)
+// Preview - 음성/영상 없이 타이틀 이미지와 본문 이미지만 outputDir에 생성합니다
+func (s *LongformWordService) Preview(ctx context.Context, request dto.VideoCreationRequest, outputDir string) error {
+	title, longformWords, err := s.GetTitleByDate(ctx, request.TargetDate)
+	if err != nil {
+		return fmt.Errorf("데이터 조회 실패: %w", err)
 	}
+
+	imageService := NewImageService()
+	titleImagePath := filepath.Join(outputDir, request.ServiceType+"_title.png")
+	if err := imageService.SetTitleOnImage(title.Title, title.SubTitle, config.Config.Paths.Templates.Title, titleImagePath); err != nil {
+		return fmt.Errorf("타이틀 이미지 생성 실패: %w", err)
+	}
+
+	words, meanings, pronunciations := s.splitLongformWords(longformWords)
+	if err := imageService.GenerateLongformImages(
+		config.Config.Paths.Templates.BackgroundImg,
+		words,
+		meanings,
+		pronunciations,
+		filepath.Join(outputDir, request.ServiceType),
+		len(longformWords)*2,
+	); err != nil {
+		return fmt.Errorf("이미지 생성 실패: %w", err)
+	}
+	return nil
+}
+
+// Plan - 렌더링 없이 타이틀, 스타트 코멘트, 본문 슬라이드 텍스트와 클립 순서, 예상 길이를 계산합니다 (Produce와 같은 규칙)
+func (s *LongformWordService) Plan(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoPlan, error) {
+	title, longformWords, err := s.GetTitleByDate(ctx, request.TargetDate)
+	if err != nil {
+		return dto.VideoPlan{}, fmt.Errorf("데이터 조회 실패: %w", err)
+	}
+	words, meanings, pronunciations := s.splitLongformWords(longformWords)
+
+	plan := dto.VideoPlan{
+		ServiceType:   request.ServiceType,
+		TargetDate:    request.TargetDate.Format("20060102"),
+		FinalFileName: request.OutputFileName,
+		Contents: planContents(dto.ContentData{
+			Primary:   words,
+			Secondary: meanings,
+			Tertiary:  pronunciations,
+			Count:     len(longformWords),
+		}),
+	}
+
+	// 1. 타이틀 (title + 무음 + subTitle + 무음)
+	plan.Slides = append(plan.Slides, planSlide(0, "titleImage.png", title.Title, title.SubTitle))
+	titleSec := estimateKoreanSpeech(title.Title, longformTitleSayRate) + longformTitleSilence
+	speech := title.Title
+	if title.SubTitle != "" {
+		titleSec += estimateKoreanSpeech(title.SubTitle, longformTitleSayRate) + longformTitleSilence
+		speech += " / " + title.SubTitle
+	}
+	plan.Clips = append(plan.Clips, dto.PlanClip{File: "title_video.mp4", Kind: clipKindTitle, Slide: "titleImage.png", Speech: speech, EstimatedSec: titleSec})
+
+	// 2. 스타트 코멘트 (이미 있으면 실제 길이, 없으면 자동 생성 시 예상 길이)
+	startCommentPath := config.Config.Paths.Templates.StartComment
+	startSec := startCommentGuessSec + goodVideoSec
+	if _, err := os.Stat(startCommentPath); err == nil {
+		if duration, err := MediaDuration(ctx, startCommentPath); err == nil {
+			startSec = duration
+		}
+	}
+	plan.Clips = append(plan.Clips, dto.PlanClip{File: startCommentPath, Kind: clipKindStart, EstimatedSec: startSec})
+
+	// 3. 본문 (짝수 - 한국어, 홀수 - 영어 반복)
+	for i := 0; i < len(longformWords)*2; i++ {
+		text, secondText := longformSlideTexts(i, words, meanings, pronunciations)
+		slideFile := fmt.Sprintf("output_%02d.png", i+1)
+		plan.Slides = append(plan.Slides, planSlide(i+1, slideFile, text, secondText))
+
+		clip := dto.PlanClip{File: fmt.Sprintf("video_%d.mp4", i), Slide: slideFile}
+		if i%2 == 0 {
+			clip.Kind = clipKindKorean
+			clip.Speech = meanings[i/2]
+			clip.EstimatedSec = estimateKoreanSpeech(meanings[i/2], longformKoreanSayRate) + longformKoreanSilence
+		} else {
+			clip.Kind = clipKindEnglish
+			clip.Speech = words[i/2]
+			clip.EstimatedSec = estimateEnglishSpeech(words[i/2], false)*longformEnglishRepeat + longformEnglishGapSec*(longformEnglishRepeat-1)
+		}
+		plan.Clips = append(plan.Clips, clip)
+	}
+
+	finishPlan(&plan)
+	return plan, nil
+}
+
+// Validate - 렌더링 없이 롱폼 단어를 본문 템플릿과 볼드 폰트로 측정한 글자 수용 범위와 비교합니다
+func (s *LongformWordService) Validate(ctx context.Context, request dto.VideoCreationRequest) (dto.ValidationReport, error) {
+	_, longformWords, err := s.GetTitleByDate(ctx, request.TargetDate)
+	if err != nil {
+		return dto.ValidationReport{}, fmt.Errorf("데이터 조회 실패: %w", err)
+	}
+	return s.validate(request, longformWords)
+}
+
+// validate - GenerateLongformImages와 같은 규칙(가로 80%, 120pt/발음 75pt부터)으로 본문 슬라이드 텍스트를 검사합니다
+func (s *LongformWordService) validate(request dto.VideoCreationRequest, longformWords []entity.LongformWord) (dto.ValidationReport, error) {
+	templatePath := config.Config.Paths.Templates.BackgroundImg
+	report := dto.ValidationReport{
+		ServiceType: request.ServiceType,
+		TargetDate:  request.TargetDate.Format("20060102"),
+		Template:    templatePath,
+		Rows:        len(longformWords),
+	}
+	capacity, err := newTextCapacity(templatePath, config.FontRoleBold, enum.LongformMaxTextWidthRatio)
+	if err != nil {
+		return report, err
+	}
+
+	rows := make([]validationRow, len(longformWords))
+	for i, lw := range longformWords {
+		rows[i] = validationRow{Id: lw.Id, Lines: []validationLine{
+			{Field: "word", Text: lw.Word, MaxSize: enum.LongformMaxFontSize, Required: true},
+			{Field: "meaning", Text: lw.Meaning, MaxSize: enum.LongformMaxFontSize, Required: true},
+			{Field: "pronunciation_kr", Text: lw.PronunciationKr, Format: "( %s )", MaxSize: enum.PronounceMaxFontSize, Required: true},
+		}}
+	}
+	report.Issues = validateRows(rows, capacity)
+	return report, nil
+}
+
+// splitLongformWords - 롱폼 단어 목록을 단어/의미/발음 배열로 분리합니다
+func (s *LongformWordService) splitLongformWords(longformWords []entity.LongformWord) ([]string, []string, []string) {
+	words := make([]string, len(longformWords))
+	meanings := make([]string, len(longformWords))
+	pronunciations := make([]string, len(longformWords))
+	for i, lw := range longformWords {
+		words[i] = lw.Word
+		meanings[i] = lw.Meaning
+		pronunciations[i] = lw.PronunciationKr
+	}
+	return words, meanings, pronunciations
 }
 
 // createTitleSequence는 타이틀 이미지, 오디오, 비디오를 모두 생성합니다.
 func (s *LongformWordService) createTitleSequence(
+	ctx context.Context,
 	title, subTitle string,
 	imageService *ImageService,
 	audioService *AudioService,
 	videoService *VideoService,
-	audioDir, videosDir string,
+	imagesDir, audioDir, videosDir string,
 ) (string, error) {
 
 	log.Println("🎬 타이틀 시퀀스를 생성합니다...")
 
 	// 1. 타이틀 이미지 생성
-	tempDir := config.Config.Paths.TempDir
-	titleImagePath := filepath.Join(tempDir, "images", "titleImage.png")
+	titleImagePath := filepath.Join(imagesDir, "titleImage.png")
 	if err := imageService.SetTitleOnImage(title, subTitle, config.Config.Paths.Templates.Title, titleImagePath); err != nil {
 		return "", fmt.Errorf("타이틀 이미지 생성 실패: %w", err)
 	}
 	log.Println("✅ 타이틀 이미지 생성 완료")
 
 	// 2. 타이틀 오디오 생성 (이미지에 표시된 title과 subTitle을 음성으로 변환)
-	slowRate := 150
+	slowRate := longformTitleSayRate
 	audioPart1Path := filepath.Join(audioDir, "title_part1.mp3")
 	defer os.Remove(audioPart1Path)
-	if err := audioService.CreateKoreanAudioWithRate(title, audioPart1Path, slowRate); err != nil {
+	if err := audioService.CreateKoreanAudioWithRate(ctx, title, audioPart1Path, slowRate); err != nil {
 		return "", fmt.Errorf("타이틀 음성(part1) 생성 실패: %w", err)
 	}
 
 	silenceAudioPath := filepath.Join(audioDir, "silence.mp3")
 	defer os.Remove(silenceAudioPath)
-	cmd := exec.Command("ffmpeg", "-f", "lavfi", "-i", "anullsrc=r=22050:cl=mono", "-t", "1.5", "-ab", "128k", "-acodec", "libmp3lame", "-y", silenceAudioPath)
-	if err := cmd.Run(); err != nil {
+	if err := runFFmpeg(ctx, "-f", "lavfi", "-i", "anullsrc=r=22050:cl=mono", "-t", fmt.Sprintf("%.1f", longformTitleSilence), "-ab", "128k", "-acodec", "libmp3lame", "-y", silenceAudioPath); err != nil {
 		return "", fmt.Errorf("무음 오디오 생성 실패: %w", err)
 	}
 
@@ -196,12 +365,12 @@ func (s *LongformWordService) createTitleSequence(
 	if subTitle != "" {
 		audioPart2Path := filepath.Join(audioDir, "title_part2.mp3")
 		defer os.Remove(audioPart2Path)
-		if err := audioService.CreateKoreanAudioWithRate(subTitle, audioPart2Path, slowRate); err != nil {
+		if err := audioService.CreateKoreanAudioWithRate(ctx, subTitle, audioPart2Path, slowRate); err != nil {
 			return "", fmt.Errorf("타이틀 음성(part2) 생성 실패: %w", err)
 		}
 
 		// title + 무음 + subTitle + 무음 합치기
-		concatCmd := exec.Command("ffmpeg",
+		concatArgs := []string{
 			"-i", audioPart1Path,
 			"-i", silenceAudioPath,
 			"-i", audioPart2Path,
@@ -211,13 +380,13 @@ func (s *LongformWordService) createTitleSequence(
 			"-acodec", "libmp3lame",
 			"-ab", "128k",
 			"-y", concatAudioPath,
-		)
-		if err := concatCmd.Run(); err != nil {
+		}
+		if err := runFFmpeg(ctx, concatArgs...); err != nil {
 			return "", fmt.Errorf("타이틀 음성 파일 합치기 실패: %w", err)
 		}
 	} else {
 		// subTitle이 없는 경우: title + 무음만 합치기
-		concatCmd := exec.Command("ffmpeg",
+		concatArgs := []string{
 			"-i", audioPart1Path,
 			"-i", silenceAudioPath,
 			"-filter_complex", "[0:a]aformat=sample_fmts=s16:sample_rates=22050:channel_layouts=mono[a0];[1:a]aformat=sample_fmts=s16:sample_rates=22050:channel_layouts=mono[a1];[a0][a1]concat=n=2:v=0:a=1[out]",
@@ -225,8 +394,8 @@ func (s *LongformWordService) createTitleSequence(
 			"-acodec", "libmp3lame",
 			"-ab", "128k",
 			"-y", concatAudioPath,
-		)
-		if err := concatCmd.Run(); err != nil {
+		}
+		if err := runFFmpeg(ctx, concatArgs...); err != nil {
 			return "", fmt.Errorf("타이틀 음성 파일 합치기 실패: %w", err)
 		}
 	}
@@ -235,7 +404,7 @@ func (s *LongformWordService) createTitleSequence(
 	// 3. 최종 타이틀 영상 생성
 	titleVideoPath := "title_video.mp4"
 	fullTitleVideoPath := filepath.Join(videosDir, titleVideoPath)
-	if err := videoService.CreateVideoToAudioLength(titleImagePath, concatAudioPath, fullTitleVideoPath); err != nil {
+	if err := videoService.CreateVideoToAudioLength(ctx, titleImagePath, concatAudioPath, fullTitleVideoPath); err != nil {
 		return "", fmt.Errorf("타이틀 영상 생성 실패: %w", err)
 	}
 	log.Println("✅ 타이틀 비디오 생성 완료")
@@ -243,27 +412,28 @@ func (s *LongformWordService) createTitleSequence(
 	return fullTitleVideoPath, nil
 }
 
-func (s *LongformWordService) getTitleByDate(ctx context.Context, targetDate time.Time) (*entity.Title, []entity.LongformWord, error) {
-	titleRepo := repository.TitleRepository()
-	longformWordRepo := repository.LongformWordRepository()
-
+// GetTitleByDate - 날짜별 롱폼 타이틀과 단어 목록을 조회합니다
+func (s *LongformWordService) GetTitleByDate(ctx context.Context, targetDate time.Time) (*entity.Title, []entity.LongformWord, error) {
+	source := DefaultContentSource()
 	dateStr := targetDate.Format("20060102")
 
-	title, err := titleRepo.FindByDate(ctx, dateStr)
+	title, err := source.Title(ctx, dateStr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("타이틀 조회 실패: %w", err)
 	}
 
-	longformWords, err := longformWordRepo.FindByDate(ctx, dateStr)
+	longformWords, err := source.LongformWords(ctx, dateStr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Longform 단어 조회 실패: %w", err)
 	}
 
 	if len(longformWords) == 0 {
-		return nil, nil, fmt.Errorf("%s에 해당하는 Longform 단어가 없습니다", dateStr)
+		return nil, nil, fmt.Errorf("%w: %s에 해당하는 Longform 단어가 없습니다", apperror.ErrNoContent, dateStr)
 	}
+	orderContent(longformWords, dateStr, contentpack.KindLongformWords)
 
 	log.Printf("데이터베이스에서 %s 날짜의 타이틀과 %d개 Longform 단어를 조회했습니다.", dateStr, len(longformWords))
+	NewContentDataServiceWithSource(source).WarnDuplicates(ctx, contentpack.KindLongformWords, dateStr)
 
 	return title, longformWords, nil
 }
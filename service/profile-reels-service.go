@@ -0,0 +1,197 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/enum"
+	"auto-video-service/progress"
+	"context"
+	"fmt"
+	"path/filepath"
+)
+
+// ProfileReelsService - profiles.yaml의 프로필 하나로 숏폼(릴스/쇼츠) 영상을 생성하는 서비스
+// 인스타그램/페이스북/유튜브 쇼츠는 옵션과 콘텐츠 조회 방식만 다르므로 프로필로 구분합니다.
+type ProfileReelsService struct {
+	profile config.PlatformProfile
+}
+
+func NewProfileReelsService(profile config.PlatformProfile) *ProfileReelsService {
+	return &ProfileReelsService{profile: profile}
+}
+
+// Produce - 프로필 설정으로 숏폼 영상 생성
+func (s *ProfileReelsService) Produce(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoCreationResponse, error) {
+	input, err := s.prepareReels(ctx, request)
+	if err != nil {
+		return dto.VideoCreationResponse{Error: err}, err
+	}
+
+	// 렌더링 전 콘텐츠 검증 (오류가 있으면 중단)
+	report, err := s.validate(input)
+	if err == nil {
+		err = enforceValidation(report)
+	}
+	if err != nil {
+		return dto.VideoCreationResponse{Error: err}, err
+	}
+
+	// 릴스 생성
+	reelsService := NewReelsCreationService()
+	response := reelsService.CreateCompleteReelsWithFontSize(ctx, input.request, input.contentData, input.templateConfig, input.options, s.profile.FontSize)
+	response.ItemIds = input.contentResult.Ids
+	response.Settings = &dto.GenerationSettings{
+		Template:           input.templateConfig.BaseTemplate,
+		EnglishRepeatCount: input.options.EnglishRepeatCount,
+		SpeakSpeed:         input.options.SpeakSpeed,
+		PauseDuration:      input.options.PauseDuration,
+		IsReverse:          input.contentData.IsReverse,
+		FontSize:           s.profile.FontSize,
+	}
+
+	if !response.Success {
+		return response, fmt.Errorf("비디오 생성 실패: %w", response.Error)
+	}
+
+	// 생성 결과 출력
+	s.printResult(input.contentResult)
+	return response, nil
+}
+
+// Preview - 음성/영상 없이 슬라이드 이미지만 outputDir에 생성합니다
+func (s *ProfileReelsService) Preview(ctx context.Context, request dto.VideoCreationRequest, outputDir string) error {
+	input, err := s.prepareReels(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	reelsService := NewReelsCreationService()
+	return reelsService.GenerateSlideImages(NewImageService(), input.contentData, input.templateConfig, filepath.Join(outputDir, request.ServiceType), s.profile.FontSize)
+}
+
+// Plan - 렌더링 없이 콘텐츠, 슬라이드 텍스트, 클립 순서, 예상 길이, 최종 파일명을 계산합니다
+func (s *ProfileReelsService) Plan(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoPlan, error) {
+	input, err := s.prepareReels(ctx, request)
+	if err != nil {
+		return dto.VideoPlan{}, err
+	}
+
+	reelsService := NewReelsCreationService()
+	return reelsService.PlanReels(input.request, input.contentData, input.options), nil
+}
+
+// Validate - 렌더링 없이 콘텐츠 행을 템플릿과 폰트로 측정한 글자 수용 범위와 비교합니다
+func (s *ProfileReelsService) Validate(ctx context.Context, request dto.VideoCreationRequest) (dto.ValidationReport, error) {
+	input, err := s.prepareReels(ctx, request)
+	if err != nil {
+		return dto.ValidationReport{}, err
+	}
+	return s.validate(input)
+}
+
+// validate - 프로필의 템플릿, 폰트 크기로 숏폼 슬라이드 텍스트를 검사합니다
+func (s *ProfileReelsService) validate(input *reelsInput) (dto.ValidationReport, error) {
+	report := dto.ValidationReport{
+		ServiceType: input.request.ServiceType,
+		TargetDate:  input.request.TargetDate.Format("20060102"),
+		Template:    input.templateConfig.BaseTemplate,
+		Rows:        len(input.contentResult.Primary),
+	}
+	capacity, err := newTextCapacity(input.templateConfig.BaseTemplate, config.FontRoleRegular, 0)
+	if err != nil {
+		return report, err
+	}
+	rows := reelsValidationRows(input.contentResult, s.profile, input.contentData.Tertiary)
+	report.Issues = validateRows(rows, capacity)
+	return report, nil
+}
+
+// prepareReels - 콘텐츠 조회 및 릴스 생성에 필요한 요청/옵션 구성
+func (s *ProfileReelsService) prepareReels(ctx context.Context, request dto.VideoCreationRequest) (*reelsInput, error) {
+	profile := s.profile
+	request.ContentType = profile.ContentType
+
+	contentStage := progress.FromContext(ctx).Stage(progress.StageContent, 0)
+	contentResult, err := s.fetchContent(ctx, request)
+	contentStage.End()
+	if err != nil {
+		return nil, fmt.Errorf("콘텐츠 조회 실패: %w", err)
+	}
+
+	contentData := dto.ContentData{
+		Primary:        contentResult.Primary,
+		PrimaryLine2:   contentResult.PrimaryLine2,
+		Secondary:      contentResult.Secondary,
+		SecondaryLine2: contentResult.SecondaryLine2,
+		Tertiary:       pronunciationLines(profile.Pronunciation, contentResult),
+		Count:          len(contentResult.Primary),
+		IsReverse:      profile.Options.IsReverse,
+	}
+
+	// 2026년부터 모든 세로형 비디오는 Vertical 템플릿 하나로 통일 (프로필에서 변경 가능)
+	templateConfig := dto.TemplateConfig{
+		BaseTemplate: profile.TemplatePath(),
+		TextColor:    profile.TextColor,
+	}
+
+	options := dto.VideoCreationOptions{
+		Platform:           profile.Platform,
+		VideoLength:        enum.VideoLengthShort,
+		EnglishRepeatCount: profile.Options.EnglishRepeatCount,
+		SpeakSpeed:         profile.Options.SpeakSpeed,
+		PauseDuration:      profile.Options.PauseDuration,
+		TemplateType:       enum.TemplateIndividual,
+	}
+	applyOverrides(&options, request.Overrides)
+
+	return &reelsInput{
+		request:        request,
+		contentData:    contentData,
+		templateConfig: templateConfig,
+		options:        options,
+		contentResult:  contentResult,
+	}, nil
+}
+
+// pronunciationLines - 프로필의 발음 표기 방식으로 영어 슬라이드 발음 줄을 만듭니다
+func pronunciationLines(style enum.PronunciationStyle, result *dto.ContentDataResult) []string {
+	lines := make([]string, len(result.Tertiary))
+	for i, korean := range result.Tertiary {
+		lines[i] = pronunciationText(style, korean, lineAt(result.Phonetic, i))
+	}
+	return lines
+}
+
+// applyOverrides - 작업 단위로 지정된 값으로 프로필 옵션을 덮어씁니다
+func applyOverrides(options *dto.VideoCreationOptions, overrides dto.OptionOverrides) {
+	if overrides.EnglishRepeatCount != nil {
+		options.EnglishRepeatCount = *overrides.EnglishRepeatCount
+	}
+	if overrides.SpeakSpeed != nil {
+		options.SpeakSpeed = *overrides.SpeakSpeed
+	}
+	if overrides.PauseDuration != nil {
+		options.PauseDuration = *overrides.PauseDuration
+	}
+}
+
+// fetchContent - 프로필의 content_source에 따라 콘텐츠를 조회합니다
+func (s *ProfileReelsService) fetchContent(ctx context.Context, request dto.VideoCreationRequest) (*dto.ContentDataResult, error) {
+	contentDataService := NewContentDataService()
+	switch s.profile.ContentSource {
+	case enum.ContentSourceYoutubeShorts:
+		return contentDataService.GetYoutubeShortsContentByDate(ctx, request.TargetDate, request.ContentType)
+	default:
+		return contentDataService.GetShortsContentByContentType(ctx, request.TargetDate, request.ContentType)
+	}
+}
+
+// printResult - 생성 결과 출력
+func (s *ProfileReelsService) printResult(result *dto.ContentDataResult) {
+	fmt.Printf("\n📱 %s 영상 생성 완료!\n", s.profile.Description)
+	fmt.Println("=" + fmt.Sprintf("%*s", 40, "") + "=")
+	for i := 0; i < len(result.Primary); i++ {
+		fmt.Printf("%d) %s (%s)\n", i+1, result.Primary[i], result.Secondary[i])
+	}
+	fmt.Println("=" + fmt.Sprintf("%*s", 40, "") + "=")
+}
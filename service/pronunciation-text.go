@@ -0,0 +1,42 @@
+package service
+
+import (
+	"auto-video-service/enum"
+	"strings"
+)
+
+// pronunciationText - 프로필의 발음 표기 방식(kr/ipa/both)에 맞춰 영어 슬라이드 발음 줄을 만듭니다
+// IPA(phonetic_symbol)가 비어 있으면 한글 발음으로 대신합니다.
+func pronunciationText(style enum.PronunciationStyle, korean, phonetic string) string {
+	ipa := ipaNotation(phonetic)
+	switch style {
+	case enum.PronunciationIPA:
+		if ipa == "" {
+			return korean
+		}
+		return ipa
+	case enum.PronunciationBoth:
+		if ipa == "" {
+			return korean
+		}
+		if strings.TrimSpace(korean) == "" {
+			return ipa
+		}
+		return korean + " " + ipa
+	default:
+		return korean
+	}
+}
+
+// ipaNotation - 발음기호를 /.../ 표기로 통일합니다 (이미 /.../ 또는 [...]로 감싸져 있으면 그대로)
+func ipaNotation(phonetic string) string {
+	phonetic = strings.TrimSpace(phonetic)
+	if phonetic == "" {
+		return ""
+	}
+	if len(phonetic) > 1 && (strings.HasPrefix(phonetic, "/") && strings.HasSuffix(phonetic, "/") ||
+		strings.HasPrefix(phonetic, "[") && strings.HasSuffix(phonetic, "]")) {
+		return phonetic
+	}
+	return "/" + phonetic + "/"
+}
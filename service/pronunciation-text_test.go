@@ -0,0 +1,28 @@
+package service
+
+import (
+	"auto-video-service/enum"
+	"testing"
+)
+
+func TestPronunciationText(t *testing.T) {
+	cases := []struct {
+		style    enum.PronunciationStyle
+		korean   string
+		phonetic string
+		want     string
+	}{
+		{enum.PronunciationKorean, "애플", "ˈæpəl", "애플"},
+		{enum.PronunciationIPA, "애플", "ˈæpəl", "/ˈæpəl/"},
+		{enum.PronunciationIPA, "애플", " [ˈæpəl] ", "[ˈæpəl]"},
+		{enum.PronunciationIPA, "애플", "", "애플"}, // IPA가 없으면 한글 발음
+		{enum.PronunciationBoth, "애플", "/ˈæpəl/", "애플 /ˈæpəl/"},
+		{enum.PronunciationBoth, "", "ˈæpəl", "/ˈæpəl/"},
+		{enum.PronunciationBoth, "애플", "", "애플"},
+	}
+	for _, c := range cases {
+		if got := pronunciationText(c.style, c.korean, c.phonetic); got != c.want {
+			t.Errorf("pronunciationText(%s, %q, %q) = %q, want %q", c.style, c.korean, c.phonetic, got, c.want)
+		}
+	}
+}
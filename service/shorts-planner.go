@@ -0,0 +1,190 @@
+package service
+
+import (
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"auto-video-service/repository"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ShortsPlanRules - 유튜브 숏폼 일정 배정 규칙
+type ShortsPlanRules struct {
+	PerDay        int                // 날짜별, 콘텐츠 타입별 항목 수 (이미 지정된 항목 포함)
+	RepeatGapDays int                // 같은 단어/숙어/문장은 최소 이 일수만큼 떨어진 날짜에만 배정 (0이면 제한 없음)
+	ContentTypes  []enum.ContentType // 날짜마다 같은 수만큼 채울 콘텐츠 타입 (ysw/ysi/yss 균형)
+}
+
+// ShortsAssignment - 롱폼 단어 한 행에 배정된 숏폼 날짜와 콘텐츠 타입
+type ShortsAssignment struct {
+	Word        entity.LongformWord
+	ShortsDate  string
+	ContentType enum.ContentType
+	Inferred    bool // content_type이 비어 있어 단어 형태로 추론함
+}
+
+// ShortsShortage - 후보가 부족해 채우지 못한 날짜/타입
+type ShortsShortage struct {
+	Date        string
+	ContentType enum.ContentType
+	Missing     int
+}
+
+// ShortsPlan - 숏폼 일정 배정 결과 (Apply 전에는 DB에 반영되지 않음)
+type ShortsPlan struct {
+	From        string
+	To          string
+	Assignments []ShortsAssignment
+	Shortages   []ShortsShortage
+	Unassigned  int // 배정되지 않고 남은 후보 수
+}
+
+// DefaultShortsContentTypes - 유튜브 숏폼 타입(ysw, ysi, yss)의 콘텐츠 타입
+var DefaultShortsContentTypes = []enum.ContentType{enum.ContentWord, enum.ContentIdiom, enum.ContentSentence}
+
+// ShortsPlannerService - 날짜가 지정되지 않은 longform_words에 shorts_date, content_type을 배정합니다
+type ShortsPlannerService struct {
+	store repository.LongformWordStore
+}
+
+func NewShortsPlannerService() *ShortsPlannerService {
+	return &ShortsPlannerService{store: repository.LongformWordRepository()}
+}
+
+// Plan - from부터 days일 동안의 배정안을 만듭니다 (DB는 읽기만 함)
+func (s *ShortsPlannerService) Plan(ctx context.Context, from time.Time, days int, rules ShortsPlanRules) (ShortsPlan, error) {
+	if days < 1 || rules.PerDay < 1 || rules.RepeatGapDays < 0 || len(rules.ContentTypes) == 0 {
+		return ShortsPlan{}, fmt.Errorf("숏폼 일정 규칙이 올바르지 않습니다 (days=%d, per-day=%d, gap=%d, types=%v)", days, rules.PerDay, rules.RepeatGapDays, rules.ContentTypes)
+	}
+
+	dates := make([]string, days)
+	for i := range dates {
+		dates[i] = from.AddDate(0, 0, i).Format("20060102")
+	}
+
+	unscheduled, err := s.store.FindUnscheduled(ctx)
+	if err != nil {
+		return ShortsPlan{}, fmt.Errorf("미배정 롱폼 단어 조회 실패: %w", err)
+	}
+	// 반복 제한은 범위 앞뒤 gap일까지의 기존 일정과 비교
+	scheduled, err := s.store.FindByShortsDateRange(ctx,
+		from.AddDate(0, 0, -rules.RepeatGapDays).Format("20060102"),
+		from.AddDate(0, 0, days-1+rules.RepeatGapDays).Format("20060102"))
+	if err != nil {
+		return ShortsPlan{}, fmt.Errorf("숏폼 일정 조회 실패: %w", err)
+	}
+	return PlanShorts(dates, unscheduled, scheduled, rules), nil
+}
+
+// Apply - 배정안을 한 트랜잭션으로 저장합니다
+func (s *ShortsPlannerService) Apply(ctx context.Context, plan ShortsPlan) (int64, error) {
+	words := make([]entity.LongformWord, 0, len(plan.Assignments))
+	for _, a := range plan.Assignments {
+		words = append(words, entity.LongformWord{Id: a.Word.Id, ShortsDate: a.ShortsDate, ContentType: string(a.ContentType)})
+	}
+	if len(words) == 0 {
+		return 0, nil
+	}
+	return s.store.AssignShorts(ctx, words)
+}
+
+// PlanShorts - 날짜 순서대로, 날짜마다 콘텐츠 타입별로 PerDay개가 되도록 후보를 오래된 것(created_date, id)부터 배정합니다
+// 이미 지정된 항목(scheduled)은 그 날짜의 개수와 반복 제한에 포함되며, 반복 제한에 걸리는 후보는 다음 날짜로 넘깁니다.
+func PlanShorts(dates []string, unscheduled []entity.LongformWord, scheduled []entity.LongformWord, rules ShortsPlanRules) ShortsPlan {
+	plan := ShortsPlan{}
+	if len(dates) == 0 {
+		return plan
+	}
+	plan.From, plan.To = dates[0], dates[len(dates)-1]
+
+	usedOn := make(map[string][]time.Time)
+	filled := make(map[string]int)
+	for _, word := range scheduled {
+		if day, err := time.Parse("20060102", word.ShortsDate); err == nil {
+			key := shortsWordKey(word.Word)
+			usedOn[key] = append(usedOn[key], day)
+		}
+		filled[word.ShortsDate+"/"+word.ContentType]++
+	}
+
+	type candidate struct {
+		word     entity.LongformWord
+		inferred bool
+	}
+	queues := make(map[enum.ContentType][]candidate)
+	for _, word := range unscheduled {
+		contentType, inferred := enum.ContentType(word.ContentType), false
+		if word.ContentType == "" {
+			contentType, inferred = InferShortsContentType(word.Word), true
+		}
+		queues[contentType] = append(queues[contentType], candidate{word: word, inferred: inferred})
+	}
+
+	for _, date := range dates {
+		day, _ := time.Parse("20060102", date)
+		for _, contentType := range rules.ContentTypes {
+			need := rules.PerDay - filled[date+"/"+string(contentType)]
+			queue := queues[contentType]
+			for i := 0; i < len(queue) && need > 0; {
+				key := shortsWordKey(queue[i].word.Word)
+				if repeatedWithin(usedOn[key], day, rules.RepeatGapDays) {
+					i++
+					continue
+				}
+				plan.Assignments = append(plan.Assignments, ShortsAssignment{
+					Word:        queue[i].word,
+					ShortsDate:  date,
+					ContentType: contentType,
+					Inferred:    queue[i].inferred,
+				})
+				usedOn[key] = append(usedOn[key], day)
+				queue = append(queue[:i], queue[i+1:]...)
+				need--
+			}
+			queues[contentType] = queue
+			if need > 0 {
+				plan.Shortages = append(plan.Shortages, ShortsShortage{Date: date, ContentType: contentType, Missing: need})
+			}
+		}
+	}
+
+	for _, queue := range queues {
+		plan.Unassigned += len(queue)
+	}
+	return plan
+}
+
+// InferShortsContentType - content_type이 없는 행의 타입을 추론합니다
+// 문장부호(. ? !)로 끝나면 sentence, 한 단어면 word, 그 밖의 여러 단어는 idiom
+func InferShortsContentType(text string) enum.ContentType {
+	text = strings.TrimSpace(text)
+	switch {
+	case strings.HasSuffix(text, ".") || strings.HasSuffix(text, "?") || strings.HasSuffix(text, "!"):
+		return enum.ContentSentence
+	case len(strings.Fields(text)) <= 1:
+		return enum.ContentWord
+	default:
+		return enum.ContentIdiom
+	}
+}
+
+// shortsWordKey - 반복 판단용 키 (대소문자, 공백 차이 무시)
+func shortsWordKey(word string) string {
+	return strings.ToLower(strings.Join(strings.Fields(word), " "))
+}
+
+// repeatedWithin - day와 gap일 미만으로 떨어진 사용 날짜가 있는지
+func repeatedWithin(used []time.Time, day time.Time, gap int) bool {
+	for _, u := range used {
+		diff := int(day.Sub(u).Hours() / 24)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < gap {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"auto-video-service/entity"
+	"auto-video-service/enum"
+	"testing"
+)
+
+func TestPlanShortsBalancesTypesAndRespectsGap(t *testing.T) {
+	dates := []string{"20261017", "20261018", "20261019"}
+	unscheduled := []entity.LongformWord{
+		{Id: 1, Word: "apple", ContentType: "word"},
+		{Id: 2, Word: "Apple", ContentType: "word"}, // 1과 같은 단어 - gap 때문에 뒤로 밀림
+		{Id: 3, Word: "banana"},                     // 추론: word
+		{Id: 4, Word: "give it a shot"},             // 추론: idiom
+		{Id: 5, Word: "It's up to you."},            // 추론: sentence
+		{Id: 6, Word: "cherry", ContentType: "word"},
+	}
+	scheduled := []entity.LongformWord{
+		{Id: 10, Word: "cherry", ShortsDate: "20261016", ContentType: "word"},    // 범위 직전에 사용 - gap 2일 이내라 17일에 배정 불가
+		{Id: 11, Word: "by heart", ShortsDate: "20261018", ContentType: "idiom"}, // 18일 idiom은 이미 채워짐
+	}
+	rules := ShortsPlanRules{PerDay: 1, RepeatGapDays: 2, ContentTypes: []enum.ContentType{enum.ContentWord, enum.ContentIdiom}}
+
+	plan := PlanShorts(dates, unscheduled, scheduled, rules)
+
+	type key struct {
+		id   int64
+		date string
+	}
+	got := map[key]enum.ContentType{}
+	for _, a := range plan.Assignments {
+		got[key{a.Word.Id, a.ShortsDate}] = a.ContentType
+	}
+	want := map[key]enum.ContentType{
+		{1, "20261017"}: enum.ContentWord,
+		{3, "20261018"}: enum.ContentWord,
+		{2, "20261019"}: enum.ContentWord,
+		{4, "20261017"}: enum.ContentIdiom,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("assignments = %+v, want %v", plan.Assignments, want)
+	}
+	for k, contentType := range want {
+		if got[k] != contentType {
+			t.Errorf("assignment %v = %q, want %q", k, got[k], contentType)
+		}
+	}
+
+	// 19일 idiom은 후보 부족, sentence(5)와 cherry(6)는 남음
+	if len(plan.Shortages) != 1 || plan.Shortages[0].Date != "20261019" || plan.Shortages[0].ContentType != enum.ContentIdiom {
+		t.Errorf("shortages = %+v", plan.Shortages)
+	}
+	if plan.Unassigned != 2 {
+		t.Errorf("Unassigned = %d, want 2", plan.Unassigned)
+	}
+}
+
+func TestInferShortsContentType(t *testing.T) {
+	cases := map[string]enum.ContentType{
+		"achieve":           enum.ContentWord,
+		"well-known":        enum.ContentWord,
+		"make up your mind": enum.ContentIdiom,
+		"I'm on my way.":    enum.ContentSentence,
+		"Are you sure?":     enum.ContentSentence,
+	}
+	for text, want := range cases {
+		if got := InferShortsContentType(text); got != want {
+			t.Errorf("InferShortsContentType(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
@@ -1,14 +1,15 @@
 package service
 
 import (
+	"auto-video-service/apperror"
 	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/progress"
 	"context"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"time"
 )
 
 type StartService struct{}
@@ -17,7 +18,8 @@ func NewStartService() *StartService {
 	return &StartService{}
 }
 
-func (s *StartService) CreateStartCommentVideo(ctx context.Context, targetDate time.Time, serviceType string) {
+// Produce - 스타트 멘트 + good 비디오를 합친 인트로 영상 생성 (출력: Templates.StartComment)
+func (s *StartService) Produce(ctx context.Context, request dto.VideoCreationRequest) (dto.VideoCreationResponse, error) {
 	log.Println("🎬 스타트 멘트와 good 비디오를 생성합니다...")
 
 	// 서비스 초기화
@@ -27,38 +29,53 @@ func (s *StartService) CreateStartCommentVideo(ctx context.Context, targetDate t
 
 	// 출력 경로 설정
 	outputPath := config.Config.Paths.Templates.StartComment
+	response := dto.VideoCreationResponse{FinalFileName: outputPath}
+
+	// 필수 템플릿 파일 확인
+	for _, path := range []string{config.Config.Paths.Templates.StartImg, config.Config.Paths.Templates.GoodImg, config.Config.StartAudioPath} {
+		if _, err := os.Stat(path); err != nil {
+			return s.fail(response, fmt.Errorf("%w: %s: %v", apperror.ErrMissingTemplate, path, err))
+		}
+	}
 
 	// 디렉토리 확인 및 생성
 	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
-		log.Fatalf("디렉토리 생성 실패: %v", err)
+		return s.fail(response, fmt.Errorf("디렉토리 생성 실패: %w", err))
 	}
 
-	// 임시 디렉토리 생성
-	tempDir := "temp/start"
-	if err := os.MkdirAll(tempDir, 0755); err != nil {
-		log.Fatalf("임시 디렉토리 생성 실패: %v", err)
+	// 작업별 임시 디렉토리 생성
+	workspace, err := NewWorkspace(request)
+	if err != nil {
+		return s.fail(response, err)
 	}
-	defer os.RemoveAll(tempDir)
+	defer workspace.Cleanup()
+	tempDir := workspace.VideosDir
+	tracker := progress.FromContext(ctx)
 
 	// 1. 스타트 멘트 비디오 생성
+	renderStage := tracker.Stage(progress.StageRender, 2)
+	defer renderStage.End() // 실패로 일찍 반환해도 단계를 닫음
 	startVideoPath := filepath.Join(tempDir, "start_temp.mp4")
-	if err := videoService.CreateStartCommentVideo(startVideoPath); err != nil {
-		log.Fatalf("스타트 멘트 비디오 생성 실패: %v", err)
+	if err := videoService.CreateStartCommentVideo(ctx, startVideoPath); err != nil {
+		return s.fail(response, fmt.Errorf("스타트 멘트 비디오 생성 실패: %w", err))
 	}
 	defer os.Remove(startVideoPath)
+	renderStage.Done()
 
 	// 2. good 비디오 생성
 	goodVideoPath := filepath.Join(tempDir, "good_temp.mp4")
-	if err := videoService.CreateGoodVideo(goodVideoPath); err != nil {
-		log.Fatalf("good 비디오 생성 실패: %v", err)
+	if err := videoService.CreateGoodVideo(ctx, goodVideoPath); err != nil {
+		return s.fail(response, fmt.Errorf("good 비디오 생성 실패: %w", err))
 	}
 	defer os.Remove(goodVideoPath)
+	renderStage.Done()
+	renderStage.End()
 
 	// 3. 두 비디오 합치기
 	fileListPath := filepath.Join(tempDir, "concat_list.txt")
 	file, err := os.Create(fileListPath)
 	if err != nil {
-		log.Fatalf("파일 목록 생성 실패: %v", err)
+		return s.fail(response, fmt.Errorf("파일 목록 생성 실패: %w", err))
 	}
 	defer os.Remove(fileListPath)
 
@@ -70,22 +87,37 @@ func (s *StartService) CreateStartCommentVideo(ctx context.Context, targetDate t
 	file.WriteString(fmt.Sprintf("file '%s'\n", absGoodPath))
 	file.Close()
 
-	// ffmpeg로 두 비디오 합치기
-	cmd := exec.Command("ffmpeg",
+	// ffmpeg로 두 비디오 합치기 (.part에 쓴 뒤 완료되면 이름 변경)
+	partialOutputPath := partialPath(outputPath)
+	defer os.Remove(partialOutputPath)
+
+	args := []string{
 		"-f", "concat",
 		"-safe", "0",
 		"-i", fileListPath,
 		"-c", "copy",
+		"-f", "mp4",
 		"-y", // 기존 파일 덮어쓰기
-		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+		partialOutputPath,
+	}
 
-	if err := cmd.Run(); err != nil {
-		log.Fatalf("비디오 합치기 실패: %v", err)
+	concatStage := tracker.Stage(progress.StageConcat, 0)
+	err = runFFmpeg(ctx, args...)
+	concatStage.End()
+	if err != nil {
+		return s.fail(response, fmt.Errorf("비디오 합치기 실패: %w", err))
+	}
+	if err := commitPartialFile(partialOutputPath, outputPath); err != nil {
+		return s.fail(response, err)
 	}
 
 	log.Printf("✅ 스타트 비디오 생성 완료: %s", outputPath)
+	response.Success = true
+	return response, nil
+}
+
+// fail - 실패 응답과 에러를 함께 반환합니다
+func (s *StartService) fail(response dto.VideoCreationResponse, err error) (dto.VideoCreationResponse, error) {
+	response.Error = err
+	return response, err
 }
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"auto-video-service/dto"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// 음성 길이 추정값 (실제 TTS를 실행하지 않는 plan 모드 전용)
+const (
+	englishWordsPerSecond = 2.5 // gTTS 보통 속도
+	englishSlowFactor     = 1.6 // gTTS slow 모드는 약 1.6배 길어짐
+	koreanCharsPerWord    = 3.0 // say -r(분당 단어 수) 기준 한국어 단어 길이
+	speechLeadSec         = 0.3 // 음성 앞뒤 여유
+	goodVideoSec          = 2.5 // CreateGoodVideo 길이
+	startCommentGuessSec  = 5.0 // 스타트 멘트 음성 길이를 알 수 없을 때 추정값
+)
+
+// estimateEnglishSpeech - 영어 음성 예상 길이 (초)
+func estimateEnglishSpeech(text string, isSlow bool) float64 {
+	words := len(strings.Fields(text))
+	sec := speechLeadSec + float64(words)/englishWordsPerSecond
+	if isSlow {
+		sec *= englishSlowFactor
+	}
+	return sec
+}
+
+// estimateKoreanSpeech - say -r rate(분당 단어 수)로 읽은 한국어 음성 예상 길이 (초)
+// 띄어쓰기 단위보다 글자 수가 길이에 더 비례하므로 공백을 뺀 글자 수로 단어 수를 환산합니다.
+func estimateKoreanSpeech(text string, rate int) float64 {
+	chars := utf8.RuneCountInString(strings.ReplaceAll(text, " ", ""))
+	words := float64(chars) / koreanCharsPerWord
+	return speechLeadSec + words*60/float64(rate)
+}
+
+// finishPlan - 클립 순번과 예상 총 길이를 채웁니다
+func finishPlan(plan *dto.VideoPlan) {
+	plan.EstimatedSec = 0
+	for i := range plan.Clips {
+		plan.Clips[i].Order = i + 1
+		plan.EstimatedSec += plan.Clips[i].EstimatedSec
+	}
+}
+
+// planContents - ContentData를 plan의 콘텐츠 행으로 변환합니다
+func planContents(contentData dto.ContentData) []dto.PlanContent {
+	contents := make([]dto.PlanContent, contentData.Count)
+	for i := 0; i < contentData.Count; i++ {
+		contents[i] = dto.PlanContent{
+			Index:          i + 1,
+			Primary:        contentData.Primary[i],
+			PrimaryLine2:   valueAt(contentData.PrimaryLine2, i),
+			Secondary:      contentData.Secondary[i],
+			SecondaryLine2: valueAt(contentData.SecondaryLine2, i),
+			Tertiary:       valueAt(contentData.Tertiary, i),
+		}
+	}
+	return contents
+}
+
+// planSlide - 비어있지 않은 줄만 모아 슬라이드 항목을 만듭니다
+func planSlide(index int, file string, lines ...string) dto.PlanSlide {
+	slide := dto.PlanSlide{Index: index, File: file, Lines: make([]string, 0, len(lines))}
+	for _, line := range lines {
+		if line != "" {
+			slide.Lines = append(slide.Lines, line)
+		}
+	}
+	return slide
+}
+
+func valueAt(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+// PlanReels - 릴스 렌더링 없이 슬라이드 텍스트, 클립 순서, 예상 길이를 계산합니다 (CreateCompleteReels와 같은 규칙)
+func (s *ReelsCreationService) PlanReels(request dto.VideoCreationRequest, contentData dto.ContentData, options dto.VideoCreationOptions) dto.VideoPlan {
+	plan := dto.VideoPlan{
+		ServiceType:   request.ServiceType,
+		TargetDate:    request.TargetDate.Format("20060102"),
+		FinalFileName: request.OutputFileName,
+		Contents:      planContents(contentData),
+	}
+
+	for i := 0; i < contentData.Count*2; i++ {
+		text, secondText, thirdText := basicSlideTexts(i, contentData.Primary, contentData.PrimaryLine2, contentData.Secondary, contentData.SecondaryLine2, contentData.Tertiary)
+		plan.Slides = append(plan.Slides, planSlide(i+1, fmt.Sprintf("output_%02d.png", i+1), text, secondText, thirdText))
+	}
+
+	// 실제 렌더링과 동일하게 공백 영상은 만들지 않음 (silenceVideoPath 미사용)
+	isSlow := options.SpeakSpeed < 1.0
+	for _, clip := range reelsClipOrder(contentData.Count, contentData.IsReverse, options, false) {
+		engContent, korContent := reelsSpeechTexts(contentData, clip.Index)
+		switch clip.Kind {
+		case clipKindEnglish:
+			plan.Clips = append(plan.Clips, dto.PlanClip{
+				File:         fmt.Sprintf("eng_%d.mp4", clip.Index),
+				Kind:         clip.Kind,
+				Slide:        fmt.Sprintf("output_%02d.png", clip.Index*2+2),
+				Speech:       engContent,
+				EstimatedSec: estimateEnglishSpeech(engContent, isSlow) + reelsClipSilence,
+			})
+		case clipKindKorean:
+			plan.Clips = append(plan.Clips, dto.PlanClip{
+				File:         fmt.Sprintf("kor_%d.mp4", clip.Index),
+				Kind:         clip.Kind,
+				Slide:        fmt.Sprintf("output_%02d.png", clip.Index*2+1),
+				Speech:       korContent,
+				EstimatedSec: estimateKoreanSpeech(korContent, reelsKoreanSayRate) + reelsClipSilence,
+			})
+		case clipKindSilence:
+			plan.Clips = append(plan.Clips, dto.PlanClip{File: "silence.mp4", Kind: clip.Kind, EstimatedSec: options.PauseDuration})
+		}
+	}
+
+	finishPlan(&plan)
+	return plan
+}
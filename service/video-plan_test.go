@@ -0,0 +1,51 @@
+package service
+
+import (
+	"auto-video-service/dto"
+	"testing"
+	"time"
+)
+
+func TestPlanReelsClipOrder(t *testing.T) {
+	contentData := dto.ContentData{
+		Primary:   []string{"apple", "banana"},
+		Secondary: []string{"사과", "바나나"},
+		Tertiary:  []string{"애플", "버내너"},
+		Count:     2,
+	}
+	request := dto.VideoCreationRequest{
+		TargetDate:     time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		ServiceType:    "fw",
+		OutputFileName: "final-video/261017_facebook_w.mp4",
+	}
+	options := dto.VideoCreationOptions{EnglishRepeatCount: 3, PauseDuration: 0.5}
+
+	plan := NewReelsCreationService().PlanReels(request, contentData, options)
+
+	want := []string{"kor_0.mp4", "eng_0.mp4", "eng_0.mp4", "eng_0.mp4", "kor_1.mp4", "eng_1.mp4", "eng_1.mp4", "eng_1.mp4"}
+	if len(plan.Clips) != len(want) {
+		t.Fatalf("clip count = %d, want %d", len(plan.Clips), len(want))
+	}
+	total := 0.0
+	for i, clip := range plan.Clips {
+		if clip.File != want[i] || clip.Order != i+1 {
+			t.Errorf("clip %d = %s (order %d), want %s", i, clip.File, clip.Order, want[i])
+		}
+		total += clip.EstimatedSec
+	}
+	if plan.EstimatedSec != total || total <= 0 {
+		t.Errorf("EstimatedSec = %f, want sum of clips %f", plan.EstimatedSec, total)
+	}
+
+	// 슬라이드: 홀수 번호 한국어, 짝수 번호 영어 + 발음
+	if len(plan.Slides) != 4 || plan.Slides[0].Lines[0] != "사과" || plan.Slides[1].Lines[1] != "( 애플 )" {
+		t.Errorf("unexpected slides: %+v", plan.Slides)
+	}
+
+	// IsReverse면 영어 반복 후 한국어
+	contentData.IsReverse = true
+	reversed := NewReelsCreationService().PlanReels(request, contentData, options)
+	if reversed.Clips[0].File != "eng_0.mp4" || reversed.Clips[3].File != "kor_0.mp4" {
+		t.Errorf("reverse order = %v, %v", reversed.Clips[0].File, reversed.Clips[3].File)
+	}
+}
@@ -2,23 +2,26 @@ package service
 
 import (
 	"auto-video-service/config"
+	"context"
 	"fmt"
+	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"time"
 )
 
 // VideoConfig 비디오 설정을 담는 구조체
 type VideoConfig struct {
-	Width  int
-	Height int
+	Width   int
+	Height  int
+	WorkDir string // 파일 목록 등 중간 파일을 쓰는 작업 디렉토리 (Workspace.VideosDir)
 }
 
 // VideoService 비디오 생성 서비스
 type VideoService struct {
 	imageService *ImageService
 	config       VideoConfig // 비디오 설정 추가
+	cache        *AssetCache // 같은 이미지/음성/인코더 설정의 클립 재사용
 }
 
 // NewVideoService 새로운 비디오 서비스 생성
@@ -26,17 +29,19 @@ func NewVideoService(imageService *ImageService, config VideoConfig) *VideoServi
 	return &VideoService{
 		imageService: imageService,
 		config:       config,
+		cache:        DefaultAssetCache(),
 	}
 }
 
 // CreateVideoWithAudioAndImage 이미지와 음성을 합쳐서 영상을 생성합니다
 func (s *VideoService) CreateVideoWithAudioAndImage(
+	ctx context.Context,
 	imagePath string,
 	audioPath string,
 	outputPath string,
 	duration float64,
 ) error {
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-i", audioPath,
@@ -56,21 +61,25 @@ func (s *VideoService) CreateVideoWithAudioAndImage(
 		"-t", fmt.Sprintf("%.2f", duration),
 		"-y",
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	return cmd.Run()
+	return runFFmpeg(ctx, args...)
 }
 
 // CreateVideoToAudioLength 이미지와 음성을 합쳐 오디오 길이에 맞는 영상을 생성합니다
-func (s *VideoService) CreateVideoToAudioLength(
+func (s *VideoService) CreateVideoToAudioLength(ctx context.Context, imagePath string, audioPath string, outputPath string) error {
+	return s.cachedClip("createVideoToAudioLength", []string{imagePath, audioPath}, nil, outputPath, func() error {
+		return s.createVideoToAudioLength(ctx, imagePath, audioPath, outputPath)
+	})
+}
+
+func (s *VideoService) createVideoToAudioLength(
+	ctx context.Context,
 	imagePath string,
 	audioPath string,
 	outputPath string,
 ) error {
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-i", audioPath,
@@ -86,22 +95,20 @@ func (s *VideoService) CreateVideoToAudioLength(
 		"-shortest", // 오디오 길이에 맞춰 비디오 종료
 		"-y",        // 기존 파일 덮어쓰기
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	return cmd.Run()
+	return runFFmpeg(ctx, args...)
 }
 
 // CreateStartCommentVideo start.png 이미지와 start_comment.mp3 음성을 합쳐 비디오를 생성합니다
 func (s *VideoService) CreateStartCommentVideo(
+	ctx context.Context,
 	outputPath string,
 ) error {
 	imagePath := config.Config.Paths.Templates.StartImg
 	audioPath := config.Config.StartAudioPath
 
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-i", audioPath,
@@ -117,22 +124,20 @@ func (s *VideoService) CreateStartCommentVideo(
 		"-shortest", // 오디오 길이에 맞춰 비디오 종료
 		"-y",        // 기존 파일 덮어쓰기
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	return cmd.Run()
+	return runFFmpeg(ctx, args...)
 }
 
 // CreateGoodVideo good.png 이미지로 무음 3초 비디오를 생성합니다
 func (s *VideoService) CreateGoodVideo(
+	ctx context.Context,
 	outputPath string,
 ) error {
 	imagePath := config.Config.Paths.Templates.GoodImg
 	duration := 2.5 // 2.5초
 
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-f", "lavfi",
@@ -149,16 +154,20 @@ func (s *VideoService) CreateGoodVideo(
 		"-t", fmt.Sprintf("%.2f", duration), // 2초 길이로 설정
 		"-y", // 기존 파일 덮어쓰기
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	return cmd.Run()
+	return runFFmpeg(ctx, args...)
 }
 
 // CreateVideoWithKorean 한국어 영상을 생성합니다 (무음 + 한국어 음성)
-func (s *VideoService) CreateVideoWithKorean(
+func (s *VideoService) CreateVideoWithKorean(ctx context.Context, imagePath string, koreanAudioPath string, outputPath string, silentTime float64) error {
+	return s.cachedClip("createVideoWithKorean", []string{imagePath, koreanAudioPath}, []string{fmt.Sprintf("%.2f", silentTime)}, outputPath, func() error {
+		return s.createVideoWithKorean(ctx, imagePath, koreanAudioPath, outputPath, silentTime)
+	})
+}
+
+func (s *VideoService) createVideoWithKorean(
+	ctx context.Context,
 	imagePath string,
 	koreanAudioPath string,
 	outputPath string,
@@ -166,24 +175,21 @@ func (s *VideoService) CreateVideoWithKorean(
 ) error {
 	// 한국어 오디오에 무음 추가 (싱크 맞춤)
 	tempKoreanPath := koreanAudioPath[:len(koreanAudioPath)-4] + "_temp.mp3"
-	koreanCmd := exec.Command("ffmpeg",
+	koreanArgs := []string{
 		"-i", koreanAudioPath,
 		"-af", fmt.Sprintf("apad=pad_dur=%.1f", silentTime),
 		"-avoid_negative_ts", "make_zero",
 		"-fflags", "+genpts",
 		"-y",
 		tempKoreanPath,
-	)
-
-	koreanCmd.Stdout = os.Stdout
-	koreanCmd.Stderr = os.Stderr
+	}
 
-	if err := koreanCmd.Run(); err != nil {
-		return fmt.Errorf("한국어 오디오 처리 실패: %v", err)
+	if err := runFFmpeg(ctx, koreanArgs...); err != nil {
+		return fmt.Errorf("한국어 오디오 처리 실패: %w", err)
 	}
 
 	// 비디오 생성 (모바일 호환성 최적화)
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-i", tempKoreanPath,
@@ -202,13 +208,10 @@ func (s *VideoService) CreateVideoWithKorean(
 		"-movflags", "+faststart",
 		"-y",
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("비디오 생성 실패: %v", err)
+	if err := runFFmpeg(ctx, args...); err != nil {
+		return fmt.Errorf("비디오 생성 실패: %w", err)
 	}
 
 	// 임시 파일 삭제
@@ -219,16 +222,24 @@ func (s *VideoService) CreateVideoWithKorean(
 
 // CreateVideoWithEnglish 영어 영상을 생성합니다 (영어 음성 1회 + 끝에 무음)
 func (s *VideoService) CreateVideoWithEnglish(
+	ctx context.Context,
 	imagePath string,
 	englishAudioPath string,
 	outputPath string,
 	silentTime float64, // 끝에 추가할 무음 시간
 ) error {
-	return s.CreateVideoWithEnglishRepeat(imagePath, englishAudioPath, outputPath, silentTime, 1)
+	return s.CreateVideoWithEnglishRepeat(ctx, imagePath, englishAudioPath, outputPath, silentTime, 1)
 }
 
 // CreateVideoWithEnglishRepeat 영어 영상을 생성합니다 (영어 음성 N회 반복 + 끝에 무음)
-func (s *VideoService) CreateVideoWithEnglishRepeat(
+func (s *VideoService) CreateVideoWithEnglishRepeat(ctx context.Context, imagePath string, englishAudioPath string, outputPath string, silentTime float64, repeatCount int) error {
+	return s.cachedClip("createVideoWithEnglishRepeat", []string{imagePath, englishAudioPath}, []string{fmt.Sprintf("%.2f", silentTime), fmt.Sprintf("%d", repeatCount)}, outputPath, func() error {
+		return s.createVideoWithEnglishRepeat(ctx, imagePath, englishAudioPath, outputPath, silentTime, repeatCount)
+	})
+}
+
+func (s *VideoService) createVideoWithEnglishRepeat(
+	ctx context.Context,
 	imagePath string,
 	englishAudioPath string,
 	outputPath string,
@@ -256,24 +267,21 @@ func (s *VideoService) CreateVideoWithEnglishRepeat(
 		filterComplex = fmt.Sprintf("apad=pad_dur=%.1f", silentTime)
 	}
 
-	englishCmd := exec.Command("ffmpeg",
+	englishArgs := []string{
 		"-i", englishAudioPath,
 		"-af", filterComplex,
 		"-avoid_negative_ts", "make_zero",
 		"-fflags", "+genpts",
 		"-y",
 		tempEnglishPath,
-	)
-
-	englishCmd.Stdout = os.Stdout
-	englishCmd.Stderr = os.Stderr
+	}
 
-	if err := englishCmd.Run(); err != nil {
-		return fmt.Errorf("영어 오디오 처리 실패: %v", err)
+	if err := runFFmpeg(ctx, englishArgs...); err != nil {
+		return fmt.Errorf("영어 오디오 처리 실패: %w", err)
 	}
 
 	// 비디오 생성 (모바일 호환성 최적화)
-	cmd := exec.Command("ffmpeg",
+	args := []string{
 		"-loop", "1",
 		"-i", imagePath,
 		"-i", tempEnglishPath,
@@ -292,25 +300,64 @@ func (s *VideoService) CreateVideoWithEnglishRepeat(
 		"-movflags", "+faststart",
 		"-y",
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("비디오 생성 실패: %v", err)
+	if err := runFFmpeg(ctx, args...); err != nil {
+		return fmt.Errorf("비디오 생성 실패: %w", err)
 	}
 
 	return nil
 }
 
+// clipEncoderSettings - 클립 인코딩 설정 요약. 인코더 옵션을 바꾸면 기존 클립 캐시가 무효화되도록 함께 수정합니다.
+const clipEncoderSettings = "libx264/fast/baseline/3.0/crf18/yuv420p/fps30+aac/128k/44100/faststart"
+
+// cachedClip - 입력 파일 내용과 설정이 같은 클립이 캐시에 있으면 복사하고, 없으면 create로 생성한 뒤 캐시에 저장합니다
+func (s *VideoService) cachedClip(kind string, inputs []string, settings []string, outputPath string, create func() error) error {
+	key := s.clipCacheKey(kind, inputs, settings)
+	if s.cache.Restore(cacheKindClip, key, outputPath) {
+		log.Printf("클립 캐시 사용: %s", outputPath)
+		return nil
+	}
+	if err := create(); err != nil {
+		return err
+	}
+	s.cache.Store(cacheKindClip, key, outputPath)
+	return nil
+}
+
+// clipCacheKey - 입력 파일 내용, 해상도, 인코더 설정으로 클립 캐시 키를 만듭니다
+func (s *VideoService) clipCacheKey(kind string, inputs []string, settings []string) string {
+	if !s.cache.Enabled() {
+		return ""
+	}
+	parts := []string{kind, clipEncoderSettings, fmt.Sprintf("%dx%d", s.config.Width, s.config.Height)}
+	for _, input := range inputs {
+		digest, err := fileDigest(input)
+		if err != nil {
+			return ""
+		}
+		parts = append(parts, digest)
+	}
+	return CacheKey(append(parts, settings...)...)
+}
+
 // ConcatenateVideos 여러 영상을 하나로 합칩니다 (메타데이터 포함)
 func (s *VideoService) ConcatenateVideos(
+	ctx context.Context,
 	videoPaths []string,
 	outputPath string,
 ) error {
-	// videos 디렉토리에서 영상 파일들 찾기
-	videosDir := "temp/videos"
+	// 중간 파일은 작업별 디렉토리에 생성 (동시에 실행되는 다른 작업과 겹치지 않음)
+	videosDir := s.config.WorkDir
+	if videosDir == "" {
+		dir, err := os.MkdirTemp("", "concat-*")
+		if err != nil {
+			return fmt.Errorf("임시 디렉토리 생성 실패: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		videosDir = dir
+	}
 
 	// 파일 목록 생성
 	fileListPath := filepath.Join(videosDir, "filelist.txt")
@@ -336,31 +383,31 @@ func (s *VideoService) ConcatenateVideos(
 	}
 	file.Close()
 
-	// 1단계: 영상 합치기 (임시 파일로)
-	tempOutputPath := outputPath + ".temp.mp4"
+	// 1단계: 영상 합치기 (임시 파일로, 최종 디렉토리가 아닌 작업 디렉토리에 생성)
+	tempOutputPath := filepath.Join(videosDir, "concat.temp.mp4")
 	defer os.Remove(tempOutputPath) // 임시 파일 삭제
 
-	concatCmd := exec.Command("ffmpeg",
+	concatArgs := []string{
 		"-f", "concat",
 		"-safe", "0",
 		"-i", fileListPath,
 		"-c", "copy",
 		"-y",
 		tempOutputPath,
-	)
-
-	concatCmd.Stdout = os.Stdout
-	concatCmd.Stderr = os.Stderr
+	}
 
-	if err := concatCmd.Run(); err != nil {
-		return fmt.Errorf("영상 합치기 실패: %v", err)
+	if err := runFFmpeg(ctx, concatArgs...); err != nil {
+		return fmt.Errorf("영상 합치기 실패: %w", err)
 	}
 
-	// 2단계: 메타데이터 추가하여 최종 파일로 저장
+	// 2단계: 메타데이터 추가하여 최종 파일로 저장 (.part에 쓴 뒤 완료되면 이름 변경)
+	partialOutputPath := partialPath(outputPath)
+	defer os.Remove(partialOutputPath)
+
 	creationTime := time.Now().Format(time.RFC3339)
 	metadata := config.Config.VideoMetadata
 
-	metadataCmd := exec.Command("ffmpeg",
+	metadataArgs := []string{
 		"-i", tempOutputPath,
 		"-c", "copy",
 		"-map_metadata", "-1", // 기존 메타데이터 제거
@@ -373,18 +420,34 @@ func (s *VideoService) ConcatenateVideos(
 		"-metadata", fmt.Sprintf("genre=%s", metadata.Category),
 		"-metadata", fmt.Sprintf("creation_time=%s", creationTime),
 		"-movflags", "+faststart",
+		"-f", "mp4",
 		"-y",
-		outputPath,
-	)
+		partialOutputPath,
+	}
+	if err := runFFmpeg(ctx, metadataArgs...); err != nil {
+		return err
+	}
+	return commitPartialFile(partialOutputPath, outputPath)
+}
 
-	metadataCmd.Stdout = os.Stdout
-	metadataCmd.Stderr = os.Stderr
-	return metadataCmd.Run()
+// partialPath - 최종 파일을 쓰는 동안 사용할 임시 경로 (완료 후 이름 변경)
+func partialPath(outputPath string) string {
+	return outputPath + ".part"
+}
+
+// commitPartialFile - 완성된 임시 파일을 최종 경로로 옮깁니다.
+// 중단/실패 시 최종 디렉토리에 반쯤 쓰인 파일이 남지 않도록 이름 변경으로 한 번에 반영합니다.
+func commitPartialFile(partialOutputPath string, outputPath string) error {
+	if err := os.Rename(partialOutputPath, outputPath); err != nil {
+		os.Remove(partialOutputPath)
+		return fmt.Errorf("최종 파일 저장 실패: %v", err)
+	}
+	return nil
 }
 
 // CreateSilenceVideo 지정된 길이의 무음/검은 화면 비디오를 생성합니다
-func (s *VideoService) CreateSilenceVideo(outputPath string, duration float64) error {
-	cmd := exec.Command("ffmpeg",
+func (s *VideoService) CreateSilenceVideo(ctx context.Context, outputPath string, duration float64) error {
+	args := []string{
 		"-f", "lavfi",
 		"-i", fmt.Sprintf("color=c=black:s=%dx%d:d=%f", s.config.Width, s.config.Height, duration), // 설정된 해상도 사용
 		"-c:v", "libx264",
@@ -392,10 +455,7 @@ func (s *VideoService) CreateSilenceVideo(outputPath string, duration float64) e
 		"-pix_fmt", "yuv420p",
 		"-y",
 		outputPath,
-	)
-
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	}
 
-	return cmd.Run()
+	return runFFmpeg(ctx, args...)
 }
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// runParallel - 0..count-1 항목을 최대 workers개씩 동시에 처리합니다
+// 한 항목이 실패하면 나머지 항목의 컨텍스트를 취소하고(실행 중인 ffmpeg/say/python3 종료)
+// 모든 작업자가 끝난 뒤 가장 앞 순서 항목의 실패 원인을 반환합니다.
+// 결과 순서는 호출자가 인덱스로 저장하므로 완료 순서와 무관하게 항상 같습니다.
+func runParallel(ctx context.Context, count int, workers int, fn func(ctx context.Context, i int) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > count {
+		workers = count
+	}
+
+	poolCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, count)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := fn(poolCtx, i); err != nil {
+					errs[i] = err
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		if poolCtx.Err() != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// 상위 컨텍스트가 취소된 경우(Ctrl-C, 작업 제한 시간)는 그 원인을 그대로 반환
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// 다른 항목의 실패로 취소된 항목보다 실제 실패 원인을 우선
+	var canceled error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, context.Canceled) {
+			if canceled == nil {
+				canceled = err
+			}
+			continue
+		}
+		return err
+	}
+	return canceled
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunParallelKeepsIndexOrder(t *testing.T) {
+	results := make([]int, 20)
+	var running, peak int32
+
+	err := runParallel(context.Background(), len(results), 4, func(ctx context.Context, i int) error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+		// 뒤 항목이 먼저 끝나도록 지연
+		time.Sleep(time.Duration(len(results)-i) * time.Millisecond)
+		results[i] = i * i
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i, v := range results {
+		if v != i*i {
+			t.Fatalf("results[%d] = %d, want %d", i, v, i*i)
+		}
+	}
+	if peak > 4 {
+		t.Errorf("Expected at most 4 concurrent workers, got %d", peak)
+	}
+}
+
+func TestRunParallelReturnsRootCauseAndCancelsOthers(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	err := runParallel(context.Background(), 10, 3, func(ctx context.Context, i int) error {
+		if i == 2 {
+			return errBoom
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+			return nil
+		}
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Expected root cause error, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const defaultTempDir = "temp"
+
+// Workspace - 작업 하나가 사용하는 임시 디렉토리 (Paths.TempDir/<타입>-<날짜>-<랜덤>)
+// 작업마다 고유한 디렉토리를 사용하므로 여러 작업을 동시에 실행해도 중간 파일이 섞이지 않습니다.
+type Workspace struct {
+	Root      string
+	ImagesDir string
+	AudioDir  string
+	VideosDir string
+}
+
+// NewWorkspace - 생성 요청에 대한 고유 작업 디렉토리를 만듭니다
+func NewWorkspace(request dto.VideoCreationRequest) (*Workspace, error) {
+	name := request.ServiceType
+	if !request.TargetDate.IsZero() {
+		name = fmt.Sprintf("%s-%s", name, request.TargetDate.Format("20060102"))
+	}
+	return newWorkspace(name)
+}
+
+// WorkspaceBaseDir - 작업 디렉토리가 생성되는 기준 디렉토리 (Paths.TempDir, 기본값 temp)
+func WorkspaceBaseDir() string {
+	if config.Config.Paths.TempDir != "" {
+		return config.Config.Paths.TempDir
+	}
+	return defaultTempDir
+}
+
+func newWorkspace(name string) (*Workspace, error) {
+	baseDir := WorkspaceBaseDir()
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return nil, fmt.Errorf("임시 디렉토리 생성 실패: %w", err)
+	}
+
+	root, err := os.MkdirTemp(baseDir, name+"-*")
+	if err != nil {
+		return nil, fmt.Errorf("작업 디렉토리 생성 실패: %w", err)
+	}
+
+	ws := &Workspace{
+		Root:      root,
+		ImagesDir: filepath.Join(root, "images"),
+		AudioDir:  filepath.Join(root, "audio"),
+		VideosDir: filepath.Join(root, "videos"),
+	}
+	for _, dir := range []string{ws.ImagesDir, ws.AudioDir, ws.VideosDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			ws.Cleanup()
+			return nil, fmt.Errorf("작업 디렉토리 생성 실패: %w", err)
+		}
+	}
+	return ws, nil
+}
+
+// Path - 작업 디렉토리 아래의 경로를 만듭니다
+func (w *Workspace) Path(elem ...string) string {
+	return filepath.Join(append([]string{w.Root}, elem...)...)
+}
+
+// Cleanup - 작업 디렉토리를 삭제합니다 (다른 작업의 디렉토리는 건드리지 않음)
+func (w *Workspace) Cleanup() {
+	log.Printf("🧹 작업 디렉토리 정리 중: %s", w.Root)
+	if err := os.RemoveAll(w.Root); err != nil {
+		log.Printf("임시 디렉토리 삭제 실패: %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewWorkspaceIsUniquePerJob(t *testing.T) {
+	originalTempDir := config.Config.Paths.TempDir
+	defer func() {
+		config.Config.Paths.TempDir = originalTempDir
+	}()
+	config.Config.Paths.TempDir = t.TempDir()
+
+	request := dto.VideoCreationRequest{
+		TargetDate:  time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		ServiceType: "iw",
+	}
+
+	first, err := NewWorkspace(request)
+	if err != nil {
+		t.Fatalf("Failed to create workspace: %v", err)
+	}
+	second, err := NewWorkspace(request)
+	if err != nil {
+		t.Fatalf("Failed to create workspace: %v", err)
+	}
+
+	if first.Root == second.Root {
+		t.Fatalf("Expected distinct workspaces for the same job, got %s twice", first.Root)
+	}
+	if filepath.Dir(first.Root) != config.Config.Paths.TempDir {
+		t.Errorf("Expected workspace under %s, got %s", config.Config.Paths.TempDir, first.Root)
+	}
+
+	first.Cleanup()
+	if _, err := os.Stat(first.Root); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed", first.Root)
+	}
+	if _, err := os.Stat(second.VideosDir); err != nil {
+		t.Errorf("Cleanup of one workspace must not touch another: %v", err)
+	}
+}
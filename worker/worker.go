@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"auto-video-service/apperror"
+	"auto-video-service/config"
+	"auto-video-service/dto"
+	"auto-video-service/entity"
+	"auto-video-service/factory"
+	"auto-video-service/repository"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// staleLockGrace - 작업 제한 시간이 지난 뒤에도 running으로 남아있으면 worker가 죽은 것으로 보고 되돌리는 여유 시간
+const staleLockGrace = 5 * time.Minute
+
+// Worker - generation_jobs 테이블에서 작업을 하나씩 가져와 실행하는 데몬
+type Worker struct {
+	id           string
+	videoFactory *factory.VideoServiceFactory
+}
+
+// NewWorker - id가 비어있으면 "<hostname>-<pid>"를 사용합니다
+func NewWorker(id string, videoFactory *factory.VideoServiceFactory) *Worker {
+	if id == "" {
+		hostname, _ := os.Hostname()
+		id = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	return &Worker{id: id, videoFactory: videoFactory}
+}
+
+// Run - ctx가 취소될 때까지 대기 작업을 가져와 실행합니다
+func (w *Worker) Run(ctx context.Context) error {
+	repo := repository.GenerationJobRepository()
+	log.Printf("👷 worker 시작: %s (조회 주기 %s, 최대 시도 %d회)", w.id, config.QueuePollInterval(), config.QueueMaxAttempts())
+	for {
+		// 비정상 종료된 worker가 남긴 작업 회수
+		staleBefore := time.Now().Add(-(config.JobTimeout() + staleLockGrace))
+		if requeued, failed, err := repo.RequeueStale(ctx, staleBefore, time.Now()); err != nil {
+			log.Printf("중단된 작업 회수 실패: %v", err)
+		} else {
+			if requeued > 0 {
+				log.Printf("♻️ 중단된 작업 %d건을 다시 대기열에 넣었습니다", requeued)
+			}
+			if failed > 0 {
+				log.Printf("❌ 중단된 작업 %d건은 최대 시도 횟수를 모두 사용해 실패 처리했습니다", failed)
+			}
+		}
+
+		job, err := repo.ClaimNext(ctx, w.id, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Printf("작업 조회 실패: %v", err)
+		}
+		if job != nil {
+			w.execute(ctx, job)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("worker 종료: %s", w.id)
+			return nil
+		case <-time.After(config.QueuePollInterval()):
+		}
+	}
+}
+
+func (w *Worker) execute(ctx context.Context, job *entity.GenerationJob) {
+	repo := repository.GenerationJobRepository()
+	log.Printf("📹 작업 #%d 시작 (%d/%d회차): 타입=%s, 날짜=%s", job.Id, job.Attempts, job.MaxAttempts, job.ServiceType, job.TargetDate)
+
+	var overrides dto.OptionOverrides
+	if job.Options != "" {
+		if err := json.Unmarshal([]byte(job.Options), &overrides); err != nil {
+			w.report(repo.MarkFailed(context.Background(), job.Id, w.id, fmt.Sprintf("options 해석 실패: %v", err), time.Now()))
+			return
+		}
+	}
+
+	// catch-up이나 같은 날 다시 등록된 작업이 이미 생성된 영상을 덮어쓰지 않도록 건너뜀 (다시 생성은 generate --force)
+	if run := w.videoFactory.AlreadyProduced(ctx, job.TargetDate, job.ServiceType); run != nil {
+		log.Printf("⏭️ 작업 #%d 건너뜀: 이미 생성됨 (%s)", job.Id, run.OutputFile)
+		w.report(repo.MarkDone(context.Background(), job.Id, w.id, run.OutputFile, time.Now()))
+		return
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, config.JobTimeout())
+	response, err := w.videoFactory.CreateVideoWithOverrides(jobCtx, job.TargetDate, job.ServiceType, overrides)
+	cancel()
+
+	// 상태 기록은 종료 요청을 받은 뒤에도 끝까지 수행
+	recordCtx := context.Background()
+	now := time.Now()
+
+	switch {
+	case err == nil:
+		log.Printf("✅ 작업 #%d 완료: %s", job.Id, response.FinalFileName)
+		w.report(repo.MarkDone(recordCtx, job.Id, w.id, response.FinalFileName, now))
+
+	case ctx.Err() != nil:
+		// worker 종료 요청 - 실패로 세지 않고 다음 실행 때 다시 시도
+		log.Printf("⏸️ 작업 #%d 중단: worker 종료 요청", job.Id)
+		w.report(repo.Release(recordCtx, job.Id, w.id, now))
+
+	case IsTransient(err) && job.Attempts < job.MaxAttempts:
+		delay := config.QueueRetryDelay(job.Attempts)
+		log.Printf("🔁 작업 #%d 일시적 실패, %s 후 재시도: %v", job.Id, delay, err)
+		w.report(repo.ScheduleRetry(recordCtx, job.Id, w.id, err.Error(), now.Add(delay)))
+
+	default:
+		log.Printf("❌ 작업 #%d 실패: %v", job.Id, err)
+		w.report(repo.MarkFailed(recordCtx, job.Id, w.id, err.Error(), now))
+	}
+}
+
+func (w *Worker) report(err error) {
+	if err != nil {
+		log.Printf("작업 상태 기록 실패: %v", err)
+	}
+}
+
+// IsTransient - 다시 시도하면 성공할 수 있는 실패인지 판별합니다 (TTS 일시 오류, 제한 시간 초과)
+// 콘텐츠 없음, 템플릿 없음처럼 데이터/설정 문제는 재시도해도 같으므로 바로 실패 처리합니다.
+func IsTransient(err error) bool {
+	return errors.Is(err, apperror.ErrTTS) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Enqueue - 배치 작업들을 대기열에 등록하고 등록된 작업 ID를 반환합니다
+func Enqueue(ctx context.Context, jobs []dto.BatchJob, overrides *dto.OptionOverrides) ([]int64, error) {
+	repo := repository.GenerationJobRepository()
+	var options string
+	if overrides != nil {
+		data, err := json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+		options = string(data)
+	}
+
+	ids := make([]int64, 0, len(jobs))
+	for _, job := range jobs {
+		row := &entity.GenerationJob{
+			ServiceType: job.ServiceType,
+			TargetDate:  job.Date,
+			Options:     options,
+			MaxAttempts: config.QueueMaxAttempts(),
+		}
+		if err := repo.Enqueue(ctx, row); err != nil {
+			return ids, fmt.Errorf("작업 등록 실패 (%s %s): %w", job.ServiceType, job.Date, err)
+		}
+		ids = append(ids, row.Id)
+	}
+	return ids, nil
+}